@@ -12,6 +12,9 @@ import (
 	"github.com/umisto/sso-svc/cmd"
 	"github.com/umisto/sso-svc/cmd/migrations"
 	"github.com/umisto/sso-svc/internal"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/token"
 )
 
 func Run(args []string) bool {
@@ -30,6 +33,28 @@ func Run(args []string) bool {
 		migrateCmd     = service.Command("migrate", "migrate command")
 		migrateUpCmd   = migrateCmd.Command("up", "migrate db up")
 		migrateDownCmd = migrateCmd.Command("down", "migrate db down")
+		keysCmd        = service.Command("keys", "signing keys command")
+		keysRotateCmd  = keysCmd.Command("rotate", "generate a new signing key and retire the active one")
+		keysRotateAlg  = keysRotateCmd.Flag("alg", "signing algorithm of the new key").
+				Default(cfg.JWT.SigningKeys.Algorithm).Enum(token.SigningAlgorithms...)
+
+		oauthClientsCmd         = service.Command("oauth-clients", "oauth clients command")
+		oauthClientsCreateCmd   = oauthClientsCmd.Command("create", "register an openid connect client")
+		oauthClientName         = oauthClientsCreateCmd.Flag("name", "name shown to the users").Required().String()
+		oauthClientRedirectURIs = oauthClientsCreateCmd.Flag("redirect-uri", "allowed redirect uri, repeatable").Required().Strings()
+		oauthClientScopes       = oauthClientsCreateCmd.Flag("scope", "allowed scope, repeatable").Default(entity.OAuthScopes...).Enums(entity.OAuthScopes...)
+		oauthClientPublic       = oauthClientsCreateCmd.Flag("public", "client without a secret, e.g. a spa or a mobile app").Bool()
+		oauthClientSkipConsent  = oauthClientsCreateCmd.Flag("skip-consent", "first party client the users do not have to approve").Bool()
+
+		outboxCmd            = service.Command("outbox", "outbox command")
+		outboxFailedCmd      = outboxCmd.Command("failed", "events the producer gave up on")
+		outboxListCmd        = outboxFailedCmd.Command("list", "list failed events")
+		outboxListLimit      = outboxListCmd.Flag("limit", "maximum number of events listed").Default("100").Int32()
+		outboxReplayCmd      = outboxFailedCmd.Command("replay", "send failed events again")
+		outboxReplayIDs      = outboxReplayCmd.Flag("id", "event id, repeatable, every failed event when omitted").Strings()
+		outboxPurgeCmd       = outboxFailedCmd.Command("purge", "delete failed events")
+		outboxPurgeIDs       = outboxPurgeCmd.Flag("id", "event id, repeatable").Strings()
+		outboxPurgeOlderThan = outboxPurgeCmd.Flag("older-than", "delete the failed events older than this, when no id is given").Default("720h").Duration()
 	)
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
@@ -50,6 +75,22 @@ func Run(args []string) bool {
 		err = migrations.MigrateUp(cfg.Database.SQL.URL)
 	case migrateDownCmd.FullCommand():
 		err = migrations.MigrateDown(cfg.Database.SQL.URL)
+	case keysRotateCmd.FullCommand():
+		err = cmd.RotateSigningKeys(ctx, cfg, log, *keysRotateAlg)
+	case oauthClientsCreateCmd.FullCommand():
+		err = cmd.CreateOAuthClient(ctx, cfg, log, auth.NewOAuthClientParams{
+			Name:         *oauthClientName,
+			RedirectURIs: *oauthClientRedirectURIs,
+			Scopes:       *oauthClientScopes,
+			Public:       *oauthClientPublic,
+			SkipConsent:  *oauthClientSkipConsent,
+		})
+	case outboxListCmd.FullCommand():
+		err = cmd.ListFailedOutboxEvents(ctx, cfg, log, *outboxListLimit)
+	case outboxReplayCmd.FullCommand():
+		err = cmd.ReplayFailedOutboxEvents(ctx, cfg, log, *outboxReplayIDs)
+	case outboxPurgeCmd.FullCommand():
+		err = cmd.PurgeFailedOutboxEvents(ctx, cfg, log, *outboxPurgeIDs, *outboxPurgeOlderThan)
 	default:
 		log.Errorf("unknown command %s", c)
 		return false
@@ -5,15 +5,19 @@ import (
 	"database/sql"
 	"sync"
 
-	"github.com/umisto/kafkakit/box"
 	"github.com/umisto/logium"
 	"github.com/umisto/sso-svc/internal"
+	"github.com/umisto/sso-svc/internal/domain/entity"
 	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/events/consumer"
 	"github.com/umisto/sso-svc/internal/events/producer"
+	"github.com/umisto/sso-svc/internal/passkey"
+	"github.com/umisto/sso-svc/internal/reaper"
 	"github.com/umisto/sso-svc/internal/repo"
 	"github.com/umisto/sso-svc/internal/rest"
 	"github.com/umisto/sso-svc/internal/rest/controller"
 	"github.com/umisto/sso-svc/internal/rest/middlewares"
+	"github.com/umisto/sso-svc/internal/social"
 	"github.com/umisto/sso-svc/internal/token"
 )
 
@@ -33,7 +37,10 @@ func StartServices(ctx context.Context, cfg internal.Config, log logium.Logger,
 
 	repository := repo.New(pg)
 
-	kafkaBox := box.New(pg)
+	keyRing := token.NewKeyRing(log, repository, cfg.JWT.SigningKeys.EncryptionKey)
+	if err = keyRing.Load(ctx); err != nil {
+		log.Fatal("failed to load signing keys, run `sso-svc keys rotate` to create one", "error", err)
+	}
 
 	jwtTokenManager := token.NewManager(token.Config{
 		AccessSK:   cfg.JWT.User.AccessToken.SecretKey,
@@ -41,16 +48,129 @@ func StartServices(ctx context.Context, cfg internal.Config, log logium.Logger,
 		AccessTTL:  cfg.JWT.User.AccessToken.TokenLifetime,
 		RefreshTTL: cfg.JWT.User.RefreshToken.TokenLifetime,
 		Iss:        cfg.Service.Name,
+
+		EmailVerificationSK:  cfg.JWT.EmailVerification.SecretKey,
+		EmailVerificationTTL: cfg.JWT.EmailVerification.TokenLifetime,
+
+		MFAChallengeSK:   cfg.JWT.MFA.SecretKey,
+		MFAChallengeTTL:  cfg.JWT.MFA.TokenLifetime,
+		MFAEncryptionKey: cfg.JWT.MFA.EncryptionKey,
+
+		Keys:    keyRing,
+		OIDCIss: cfg.OIDC.Issuer,
 	})
 
-	kafkaProducer := producer.New(log, cfg.Kafka.Brokers, kafkaBox)
+	// events go to the outbox through the repository, so they join the transactions of
+	// the changes they announce
+	kafkaProducer, err := producer.New(log, producer.Config{
+		Brokers:        cfg.Kafka.Brokers,
+		BatchSize:      cfg.Kafka.Outbox.BatchSize,
+		Lease:          cfg.Kafka.Outbox.Lease,
+		MaxAttempts:    cfg.Kafka.Outbox.MaxAttempts,
+		RetryBaseDelay: cfg.Kafka.Outbox.RetryBaseDelay,
+		RetryMaxDelay:  cfg.Kafka.Outbox.RetryMaxDelay,
+		DLQTopic:       cfg.Kafka.Outbox.DLQTopic,
+	}, repository)
+	if err != nil {
+		log.Fatal("failed to create outbox producer", "error", err)
+	}
+
+	passkeyRP, err := passkey.NewRelyingParty(passkey.Config{
+		RPID:          cfg.WebAuthn.RPID,
+		RPDisplayName: cfg.WebAuthn.RPDisplayName,
+		RPOrigins:     cfg.WebAuthn.RPOrigins,
+	})
+	if err != nil {
+		log.Fatal("failed to create webauthn relying party", "error", err)
+	}
 
-	core := auth.NewService(repository, jwtTokenManager, kafkaProducer)
+	switch cfg.Sessions.OnLimit {
+	case "", entity.SessionLimitReject, entity.SessionLimitEvictLRU:
+	default:
+		log.Fatal("unsupported sessions on_limit policy", "policy", cfg.Sessions.OnLimit)
+	}
 
-	ctrl := controller.New(log, cfg.GoogleOAuth(), core)
-	mdlv := middlewares.New(log)
+	core := auth.NewService(repository, jwtTokenManager, kafkaProducer, passkeyRP, auth.Config{
+		Lockout: auth.LockoutConfig{
+			Account: entity.LoginLockoutPolicy{
+				MaxAttempts: cfg.Lockout.Account.MaxAttempts,
+				Window:      cfg.Lockout.Account.Window,
+				BaseLockout: cfg.Lockout.Account.BaseDuration,
+				MaxLockout:  cfg.Lockout.Account.MaxDuration,
+				ResetAfter:  cfg.Lockout.Account.ResetAfter,
+			},
+			IP: entity.LoginLockoutPolicy{
+				MaxAttempts: cfg.Lockout.IP.MaxAttempts,
+				Window:      cfg.Lockout.IP.Window,
+				BaseLockout: cfg.Lockout.IP.BaseDuration,
+				MaxLockout:  cfg.Lockout.IP.MaxDuration,
+				ResetAfter:  cfg.Lockout.IP.ResetAfter,
+			},
+		},
+		Sessions: auth.SessionsConfig{
+			RevokeAllOnTokenReuse: cfg.Sessions.RevokeAllOnTokenReuse,
+			Expiry: entity.SessionExpiry{
+				IdleTimeout:      cfg.Sessions.IdleTimeout,
+				AbsoluteLifetime: cfg.Sessions.AbsoluteLifetime,
+			},
+			Limit: entity.SessionLimitPolicy{
+				MaxPerRole: cfg.Sessions.MaxPerRole,
+				OnLimit:    cfg.Sessions.OnLimit,
+			},
+		},
+		OAuth: auth.OAuthConfig{
+			AuthorizationCodeTTL: cfg.OIDC.AuthorizationCodeLifetime,
+		},
+		Social: auth.SocialConfig{
+			AutoProvision: cfg.Social.AutoProvision,
+			StateTTL:      cfg.Social.StateLifetime,
+			RedirectURIs:  cfg.Social.RedirectURIs,
+		},
+	})
+
+	socialProviders, err := social.NewRegistry(ctx, cfg.SocialProviders())
+	if err != nil {
+		log.Fatal("failed to create login providers", "error", err)
+	}
+
+	ctrl := controller.New(log, socialProviders, controller.OIDCConfig{
+		Issuer:    cfg.OIDC.Issuer,
+		LoginURL:  cfg.OIDC.LoginURL,
+		AccessTTL: cfg.JWT.User.AccessToken.TokenLifetime,
+	}, core, keyRing)
+	trustedProxies, err := middlewares.ParseTrustedProxies(cfg.Rest.TrustedProxies)
+	if err != nil {
+		log.Fatal("failed to parse trusted proxies", "error", err)
+	}
+
+	mdlv := middlewares.New(log, jwtTokenManager, trustedProxies)
 
 	run(func() { rest.Run(ctx, cfg, log, mdlv, ctrl) })
 
+	if cfg.Rest.MetricsPort != "" {
+		run(func() { rest.RunMetrics(ctx, cfg, log) })
+	}
+
 	run(func() { kafkaProducer.Run(ctx) })
+
+	if cfg.Kafka.Inbox.Enabled {
+		kafkaConsumer := consumer.New(log, consumer.Config{
+			Brokers:          cfg.Kafka.Brokers,
+			GroupID:          cfg.Kafka.Inbox.GroupID,
+			Topics:           cfg.Kafka.Inbox.Topics,
+			AllowedProducers: cfg.Kafka.Inbox.AllowedProducers,
+			MaxAttempts:      cfg.Kafka.Inbox.MaxAttempts,
+			RetryBaseDelay:   cfg.Kafka.Inbox.RetryBaseDelay,
+			RetryMaxDelay:    cfg.Kafka.Inbox.RetryMaxDelay,
+		}, repository, core)
+
+		run(func() { kafkaConsumer.Run(ctx) })
+	}
+
+	run(func() { keyRing.Run(ctx, cfg.JWT.SigningKeys.ReloadInterval) })
+
+	if cfg.Sessions.SweepInterval > 0 && cfg.Sessions.SweepBatchSize > 0 {
+		sessionsReaper := reaper.NewSessions(log, core, cfg.Sessions.SweepBatchSize)
+		run(func() { sessionsReaper.Run(ctx, cfg.Sessions.SweepInterval) })
+	}
 }
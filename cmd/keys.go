@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/umisto/logium"
+	"github.com/umisto/sso-svc/internal"
+	"github.com/umisto/sso-svc/internal/repo"
+	"github.com/umisto/sso-svc/internal/token"
+)
+
+// RotateSigningKeys generates a new active signing key and retires the current one. The
+// retired key keeps verifying tokens until every token it could have signed has expired.
+func RotateSigningKeys(ctx context.Context, cfg internal.Config, log logium.Logger, algorithm string) error {
+	pg, err := sql.Open("postgres", cfg.Database.SQL.URL)
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	defer pg.Close()
+
+	repository := repo.New(pg)
+
+	key, err := token.NewSigningKey(algorithm, cfg.JWT.SigningKeys.EncryptionKey)
+	if err != nil {
+		return err
+	}
+
+	ttl := max(cfg.JWT.User.AccessToken.TokenLifetime, cfg.JWT.User.RefreshToken.TokenLifetime)
+	retireAt := time.Now().UTC().Add(ttl)
+
+	if err = repository.RotateSigningKeys(ctx, key, retireAt); err != nil {
+		return fmt.Errorf("rotate signing keys: %w", err)
+	}
+
+	log.Infof("signing key %s (%s) is active, previous keys retire at %s", key.ID, key.Algorithm, retireAt.Format(time.RFC3339))
+
+	return nil
+}
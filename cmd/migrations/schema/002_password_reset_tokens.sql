@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE password_reset_tokens (
+    id         UUID        PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    account_id UUID        NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    token_hash TEXT        NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at    TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX password_reset_tokens_account_id_idx ON password_reset_tokens (account_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS password_reset_tokens CASCADE;
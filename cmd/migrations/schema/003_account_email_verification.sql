@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE account_emails ADD COLUMN verification_sent_at TIMESTAMPTZ;
+
+-- +migrate Down
+ALTER TABLE account_emails DROP COLUMN IF EXISTS verification_sent_at;
@@ -0,0 +1,16 @@
+-- +migrate Up
+ALTER TABLE account_emails ALTER COLUMN email TYPE VARCHAR(255);
+ALTER TABLE account_emails ADD COLUMN email_changed_at TIMESTAMPTZ;
+
+CREATE TABLE account_email_changes (
+    account_id UUID         NOT NULL PRIMARY KEY REFERENCES accounts(id) ON DELETE CASCADE,
+    new_email  VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMPTZ  NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_email_changes CASCADE;
+
+ALTER TABLE account_emails DROP COLUMN IF EXISTS email_changed_at;
+ALTER TABLE account_emails ALTER COLUMN email TYPE VARCHAR(32);
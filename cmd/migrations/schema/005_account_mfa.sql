@@ -0,0 +1,23 @@
+-- +migrate Up
+CREATE TABLE account_mfa (
+    account_id     UUID        NOT NULL PRIMARY KEY REFERENCES accounts(id) ON DELETE CASCADE,
+    totp_secret    TEXT        NOT NULL,
+    enabled        BOOLEAN     NOT NULL DEFAULT FALSE,
+    totp_last_step BIGINT      NOT NULL DEFAULT 0,
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE account_mfa_recovery_codes (
+    id         UUID        PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    account_id UUID        NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    code_hash  VARCHAR(64) NOT NULL,
+    used_at    TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    UNIQUE (account_id, code_hash)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_mfa_recovery_codes CASCADE;
+DROP TABLE IF EXISTS account_mfa CASCADE;
@@ -0,0 +1,31 @@
+-- +migrate Up
+CREATE TABLE webauthn_credentials (
+    id               UUID        PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    account_id       UUID        NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    credential_id    BYTEA       NOT NULL UNIQUE,
+    public_key       BYTEA       NOT NULL,
+    attestation_type VARCHAR(32) NOT NULL,
+    transports       TEXT[]      NOT NULL DEFAULT '{}',
+    sign_count       BIGINT      NOT NULL DEFAULT 0,
+    aaguid           BYTEA,
+    backup_eligible  BOOLEAN     NOT NULL DEFAULT FALSE,
+    backup_state     BOOLEAN     NOT NULL DEFAULT FALSE,
+    name             VARCHAR(64) NOT NULL,
+    last_used_at     TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX webauthn_credentials_account_id_idx ON webauthn_credentials (account_id);
+
+CREATE TABLE webauthn_sessions (
+    id         UUID        PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    account_id UUID        REFERENCES accounts(id) ON DELETE CASCADE,
+    kind       VARCHAR(16) NOT NULL,
+    data       BYTEA       NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS webauthn_sessions CASCADE;
+DROP TABLE IF EXISTS webauthn_credentials CASCADE;
@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE login_throttles (
+    kind            VARCHAR(16) NOT NULL,
+    subject         VARCHAR(64) NOT NULL,
+    failed_attempts INTEGER     NOT NULL DEFAULT 0,
+    lockouts        INTEGER     NOT NULL DEFAULT 0,
+    locked_until    TIMESTAMPTZ,
+    last_failed_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (kind, subject)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS login_throttles CASCADE;
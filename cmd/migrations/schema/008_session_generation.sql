@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE sessions ADD COLUMN generation BIGINT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE sessions DROP COLUMN IF EXISTS generation;
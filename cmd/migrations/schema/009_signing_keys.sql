@@ -0,0 +1,12 @@
+-- +migrate Up
+CREATE TABLE signing_keys (
+    id          VARCHAR(64) PRIMARY KEY NOT NULL,
+    algorithm   VARCHAR(16) NOT NULL,
+    private_key TEXT        NOT NULL,
+    status      VARCHAR(16) NOT NULL,
+    expires_at  TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS signing_keys CASCADE;
@@ -0,0 +1,40 @@
+-- +migrate Up
+CREATE TABLE oauth_clients (
+    id            VARCHAR(64) PRIMARY KEY NOT NULL,
+    secret_hash   VARCHAR(64),
+    name          VARCHAR(64) NOT NULL,
+    redirect_uris TEXT[]      NOT NULL,
+    scopes        TEXT[]      NOT NULL,
+    skip_consent  BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE oauth_authorization_codes (
+    code_hash             VARCHAR(64)  PRIMARY KEY NOT NULL,
+    client_id             VARCHAR(64)  NOT NULL REFERENCES oauth_clients(id) ON DELETE CASCADE,
+    account_id            UUID         NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    redirect_uri          TEXT         NOT NULL,
+    scopes                TEXT[]       NOT NULL,
+    nonce                 VARCHAR(255) NOT NULL DEFAULT '',
+    code_challenge        VARCHAR(128) NOT NULL,
+    code_challenge_method VARCHAR(8)   NOT NULL,
+    auth_time             TIMESTAMPTZ  NOT NULL,
+    expires_at            TIMESTAMPTZ  NOT NULL,
+    created_at            TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE TABLE oauth_consents (
+    account_id UUID        NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    client_id  VARCHAR(64) NOT NULL REFERENCES oauth_clients(id) ON DELETE CASCADE,
+    scopes     TEXT[]      NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (account_id, client_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS oauth_consents CASCADE;
+DROP TABLE IF EXISTS oauth_authorization_codes CASCADE;
+DROP TABLE IF EXISTS oauth_clients CASCADE;
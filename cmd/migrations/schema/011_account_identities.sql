@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE account_identities (
+    id         UUID         PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    account_id UUID         NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    provider   VARCHAR(64)  NOT NULL,
+    subject    VARCHAR(255) NOT NULL,
+    email      VARCHAR(255) NOT NULL DEFAULT '',
+    linked_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+
+    UNIQUE (provider, subject)
+);
+
+CREATE INDEX account_identities_account_id_idx ON account_identities (account_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_identities CASCADE;
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE social_login_states (
+    state_hash    VARCHAR(64)  PRIMARY KEY NOT NULL,
+    provider      VARCHAR(64)  NOT NULL,
+    purpose       VARCHAR(16)  NOT NULL,
+    account_id    UUID         REFERENCES accounts(id) ON DELETE CASCADE,
+    session_id    UUID,
+    code_verifier VARCHAR(128) NOT NULL,
+    nonce         VARCHAR(64)  NOT NULL,
+    redirect_uri  TEXT         NOT NULL DEFAULT '',
+    expires_at    TIMESTAMPTZ  NOT NULL,
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS social_login_states CASCADE;
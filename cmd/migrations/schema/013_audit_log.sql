@@ -0,0 +1,18 @@
+-- +migrate Up
+CREATE TABLE audit_log (
+    id         UUID        PRIMARY KEY NOT NULL,
+    actor_id   UUID,
+    target_id  UUID,
+    action     VARCHAR(64) NOT NULL,
+    ip         VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent TEXT        NOT NULL DEFAULT '',
+    metadata   JSONB       NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX audit_log_created_at_idx ON audit_log (created_at DESC, id DESC);
+CREATE INDEX audit_log_actor_id_idx ON audit_log (actor_id, created_at DESC);
+CREATE INDEX audit_log_target_id_idx ON audit_log (target_id, created_at DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS audit_log CASCADE;
@@ -0,0 +1,19 @@
+-- +migrate Up
+ALTER TABLE sessions
+    ADD COLUMN ip          VARCHAR(64)  NOT NULL DEFAULT '',
+    ADD COLUMN user_agent  TEXT         NOT NULL DEFAULT '',
+    ADD COLUMN device      VARCHAR(16)  NOT NULL DEFAULT '',
+    ADD COLUMN os          VARCHAR(32)  NOT NULL DEFAULT '',
+    ADD COLUMN browser     VARCHAR(32)  NOT NULL DEFAULT '',
+    ADD COLUMN client_name VARCHAR(128) NOT NULL DEFAULT '',
+    ADD COLUMN geo_hint    VARCHAR(64)  NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS ip,
+    DROP COLUMN IF EXISTS user_agent,
+    DROP COLUMN IF EXISTS device,
+    DROP COLUMN IF EXISTS os,
+    DROP COLUMN IF EXISTS browser,
+    DROP COLUMN IF EXISTS client_name,
+    DROP COLUMN IF EXISTS geo_hint;
@@ -0,0 +1,15 @@
+-- +migrate Up
+ALTER TABLE outbox_events
+    ADD COLUMN locked_until TIMESTAMPTZ;
+
+CREATE INDEX outbox_events_pending_idx    ON outbox_events (created_at) WHERE status = 'pending';
+CREATE INDEX outbox_events_processing_idx ON outbox_events (locked_until) WHERE status = 'processing';
+
+-- +migrate Down
+UPDATE outbox_events SET status = 'pending' WHERE status = 'processing';
+
+DROP INDEX IF EXISTS outbox_events_processing_idx;
+DROP INDEX IF EXISTS outbox_events_pending_idx;
+
+ALTER TABLE outbox_events
+    DROP COLUMN IF EXISTS locked_until;
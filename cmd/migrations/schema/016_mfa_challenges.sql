@@ -0,0 +1,11 @@
+-- +migrate Up
+CREATE TABLE mfa_challenges (
+    id         UUID        PRIMARY KEY NOT NULL,
+    account_id UUID        NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    attempts   INTEGER     NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS mfa_challenges CASCADE;
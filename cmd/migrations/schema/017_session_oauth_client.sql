@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE sessions
+    ADD COLUMN oauth_client_id VARCHAR(64) NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS oauth_client_id;
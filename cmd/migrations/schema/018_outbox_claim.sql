@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE outbox_events
+    ADD COLUMN claim_id UUID;
+
+-- +migrate Down
+ALTER TABLE outbox_events
+    DROP COLUMN IF EXISTS claim_id;
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/umisto/logium"
+	"github.com/umisto/sso-svc/internal"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/repo"
+)
+
+// CreateOAuthClient registers an openid connect client. The secret is only ever shown
+// here, it has to be handed over to the client owner right away.
+func CreateOAuthClient(ctx context.Context, cfg internal.Config, log logium.Logger, params auth.NewOAuthClientParams) error {
+	pg, err := sql.Open("postgres", cfg.Database.SQL.URL)
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	defer pg.Close()
+
+	repository := repo.New(pg)
+
+	client, secret, err := auth.NewOAuthClient(params)
+	if err != nil {
+		return err
+	}
+
+	client, err = repository.CreateOAuthClient(ctx, client)
+	if err != nil {
+		return fmt.Errorf("create oauth client: %w", err)
+	}
+
+	if client.IsPublic() {
+		log.Infof("public oauth client %q created, client_id: %s", client.Name, client.ID)
+	} else {
+		log.Infof("oauth client %q created, client_id: %s, client_secret: %s", client.Name, client.ID, secret)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/logium"
+	"github.com/umisto/sso-svc/internal"
+	"github.com/umisto/sso-svc/internal/repo"
+)
+
+// ListFailedOutboxEvents logs the events the producer gave up on, the oldest first.
+func ListFailedOutboxEvents(ctx context.Context, cfg internal.Config, log logium.Logger, limit int32) error {
+	pg, err := sql.Open("postgres", cfg.Database.SQL.URL)
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	defer pg.Close()
+
+	events, err := repo.New(pg).GetFailedOutboxEvents(ctx, limit)
+	if err != nil {
+		return fmt.Errorf("get failed outbox events: %w", err)
+	}
+
+	for _, event := range events {
+		log.Infof("event %s, type %s, topic %s, key %s, attempts %d, created at %s",
+			event.ID, event.Type, event.Topic, event.Key, event.Attempts, event.CreatedAt.Format(time.RFC3339))
+	}
+	log.Infof("%d failed outbox events", len(events))
+
+	return nil
+}
+
+// ReplayFailedOutboxEvents hands failed events back to the producer with fresh attempts,
+// every failed event when ids is empty.
+func ReplayFailedOutboxEvents(ctx context.Context, cfg internal.Config, log logium.Logger, ids []string) error {
+	eventIDs, err := parseEventIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	pg, err := sql.Open("postgres", cfg.Database.SQL.URL)
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	defer pg.Close()
+
+	events, err := repo.New(pg).ReplayFailedOutboxEvents(ctx, eventIDs)
+	if err != nil {
+		return fmt.Errorf("replay failed outbox events: %w", err)
+	}
+
+	log.Infof("%d failed outbox events queued again", len(events))
+
+	return nil
+}
+
+// PurgeFailedOutboxEvents deletes failed events for good, the given ones or, when ids is
+// empty, every one older than olderThan.
+func PurgeFailedOutboxEvents(
+	ctx context.Context,
+	cfg internal.Config,
+	log logium.Logger,
+	ids []string,
+	olderThan time.Duration,
+) error {
+	eventIDs, err := parseEventIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	pg, err := sql.Open("postgres", cfg.Database.SQL.URL)
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	defer pg.Close()
+
+	deleted, err := repo.New(pg).PurgeFailedOutboxEvents(ctx, eventIDs, time.Now().UTC().Add(-olderThan))
+	if err != nil {
+		return fmt.Errorf("purge failed outbox events: %w", err)
+	}
+
+	log.Infof("%d failed outbox events deleted", deleted)
+
+	return nil
+}
+
+func parseEventIDs(ids []string) ([]uuid.UUID, error) {
+	res := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		eventID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid event id %q: %w", id, err)
+		}
+		res = append(res, eventID)
+	}
+
+	return res, nil
+}
@@ -7,8 +7,7 @@ import (
 	_ "github.com/lib/pq" // postgres driver don`t delete
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
+	"github.com/umisto/sso-svc/internal/social"
 )
 
 type ServerConfig struct {
@@ -21,8 +20,14 @@ type LogConfig struct {
 }
 
 type RestConfig struct {
-	Port     string `mapstructure:"port"`
-	Timeouts struct {
+	Port string `mapstructure:"port"`
+	// MetricsPort serves the expvar metrics, empty disables it.
+	MetricsPort string `mapstructure:"metrics_port"`
+	// TrustedProxies lists the CIDRs of the proxies in front of the service, the client
+	// address is read from their X-Forwarded-For and X-Real-IP headers. The headers of
+	// any other peer are ignored.
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
+	Timeouts       struct {
 		Read       time.Duration `mapstructure:"read"`
 		ReadHeader time.Duration `mapstructure:"read_header"`
 		Write      time.Duration `mapstructure:"write"`
@@ -36,16 +41,56 @@ type DatabaseConfig struct {
 	} `mapstructure:"sql"`
 }
 
-type OAuthConfig struct {
-	Google struct {
-		ClientID     string `mapstructure:"client_id"`
-		ClientSecret string `mapstructure:"client_secret"`
-		RedirectURL  string `mapstructure:"redirect_url"`
-	}
+type SocialProviderConfig struct {
+	Type         string   `mapstructure:"type"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	RedirectURL  string   `mapstructure:"redirect_url"`
+	Scopes       []string `mapstructure:"scopes"`
+	Issuer       string   `mapstructure:"issuer"`
+	Tenant       string   `mapstructure:"tenant"`
+	AuthURL      string   `mapstructure:"auth_url"`
+	TokenURL     string   `mapstructure:"token_url"`
+	APIURL       string   `mapstructure:"api_url"`
+}
+
+type SocialConfig struct {
+	// AutoProvision creates an account on the first login of a provider user that has
+	// no account yet, instead of refusing the login. The provider must have verified
+	// the email of the user.
+	AutoProvision bool `mapstructure:"auto_provision"`
+	// StateLifetime limits the time between the redirect to the provider and its callback.
+	StateLifetime time.Duration `mapstructure:"state_lifetime"`
+	// RedirectURIs lists the pages the user agent may be sent to after the callback.
+	RedirectURIs []string                        `mapstructure:"redirect_uris"`
+	Providers    map[string]SocialProviderConfig `mapstructure:"providers"`
 }
 
 type KafkaConfig struct {
-	Brokers []string `mapstructure:"brokers"`
+	Brokers []string          `mapstructure:"brokers"`
+	Outbox  KafkaOutboxConfig `mapstructure:"outbox"`
+	Inbox   KafkaInboxConfig  `mapstructure:"inbox"`
+}
+
+type KafkaOutboxConfig struct {
+	BatchSize      int32         `mapstructure:"batch_size"`
+	Lease          time.Duration `mapstructure:"lease"`
+	MaxAttempts    int32         `mapstructure:"max_attempts"`
+	RetryBaseDelay time.Duration `mapstructure:"retry_base_delay"`
+	RetryMaxDelay  time.Duration `mapstructure:"retry_max_delay"`
+	DLQTopic       string        `mapstructure:"dlq_topic"`
+}
+
+type KafkaInboxConfig struct {
+	Enabled bool     `mapstructure:"enabled"`
+	GroupID string   `mapstructure:"group_id"`
+	Topics  []string `mapstructure:"topics"`
+	// AllowedProducers lists the services whose events are handled. The events suspend
+	// and delete accounts, so the topics must have ACLs letting only these services write.
+	AllowedProducers []string      `mapstructure:"allowed_producers"`
+	MaxAttempts      int32         `mapstructure:"max_attempts"`
+	RetryBaseDelay   time.Duration `mapstructure:"retry_base_delay"`
+	RetryMaxDelay    time.Duration `mapstructure:"retry_max_delay"`
 }
 
 type JWTConfig struct {
@@ -60,6 +105,55 @@ type JWTConfig struct {
 			TokenLifetime time.Duration `mapstructure:"token_lifetime"`
 		} `mapstructure:"refresh_token"`
 	} `mapstructure:"user"`
+	EmailVerification struct {
+		SecretKey     string        `mapstructure:"secret_key"`
+		TokenLifetime time.Duration `mapstructure:"token_lifetime"`
+	} `mapstructure:"email_verification"`
+	MFA struct {
+		SecretKey     string        `mapstructure:"secret_key"`
+		EncryptionKey string        `mapstructure:"encryption_key"`
+		TokenLifetime time.Duration `mapstructure:"token_lifetime"`
+	} `mapstructure:"mfa"`
+	SigningKeys struct {
+		EncryptionKey  string        `mapstructure:"encryption_key"`
+		Algorithm      string        `mapstructure:"algorithm"`
+		ReloadInterval time.Duration `mapstructure:"reload_interval"`
+	} `mapstructure:"signing_keys"`
+}
+
+type OIDCConfig struct {
+	Issuer                    string        `mapstructure:"issuer"`
+	LoginURL                  string        `mapstructure:"login_url"`
+	AuthorizationCodeLifetime time.Duration `mapstructure:"authorization_code_lifetime"`
+}
+
+type WebAuthnConfig struct {
+	RPID          string   `mapstructure:"rp_id"`
+	RPDisplayName string   `mapstructure:"rp_display_name"`
+	RPOrigins     []string `mapstructure:"rp_origins"`
+}
+
+type LockoutPolicyConfig struct {
+	MaxAttempts  int32         `mapstructure:"max_attempts"`
+	Window       time.Duration `mapstructure:"window"`
+	BaseDuration time.Duration `mapstructure:"base_duration"`
+	MaxDuration  time.Duration `mapstructure:"max_duration"`
+	ResetAfter   time.Duration `mapstructure:"reset_after"`
+}
+
+type LockoutConfig struct {
+	Account LockoutPolicyConfig `mapstructure:"account"`
+	IP      LockoutPolicyConfig `mapstructure:"ip"`
+}
+
+type SessionsConfig struct {
+	RevokeAllOnTokenReuse bool           `mapstructure:"revoke_all_on_token_reuse"`
+	IdleTimeout           time.Duration  `mapstructure:"idle_timeout"`
+	AbsoluteLifetime      time.Duration  `mapstructure:"absolute_lifetime"`
+	SweepInterval         time.Duration  `mapstructure:"sweep_interval"`
+	SweepBatchSize        uint64         `mapstructure:"sweep_batch_size"`
+	MaxPerRole            map[string]int `mapstructure:"max_per_role"`
+	OnLimit               string         `mapstructure:"on_limit"`
 }
 
 type SwaggerConfig struct {
@@ -73,10 +167,14 @@ type Config struct {
 	Log      LogConfig      `mapstructure:"log"`
 	Rest     RestConfig     `mapstructure:"rest"`
 	JWT      JWTConfig      `mapstructure:"jwt"`
-	OAuth    OAuthConfig    `mapstructure:"oauth"`
+	Social   SocialConfig   `mapstructure:"social"`
+	OIDC     OIDCConfig     `mapstructure:"oidc"`
 	Kafka    KafkaConfig    `mapstructure:"kafka"`
 	Database DatabaseConfig `mapstructure:"database"`
 	Swagger  SwaggerConfig  `mapstructure:"swagger"`
+	WebAuthn WebAuthnConfig `mapstructure:"webauthn"`
+	Lockout  LockoutConfig  `mapstructure:"lockout"`
+	Sessions SessionsConfig `mapstructure:"sessions"`
 }
 
 func LoadConfig() (Config, error) {
@@ -98,12 +196,24 @@ func LoadConfig() (Config, error) {
 	return config, nil
 }
 
-func (c *Config) GoogleOAuth() oauth2.Config {
-	return oauth2.Config{
-		ClientID:     c.OAuth.Google.ClientID,
-		ClientSecret: c.OAuth.Google.ClientSecret,
-		RedirectURL:  c.OAuth.Google.RedirectURL,
-		Scopes:       []string{"https://www.googleapis.com/auth/userinfo.email", "https://www.googleapis.com/auth/userinfo.profile"},
-		Endpoint:     google.Endpoint,
+// SocialProviders returns the login providers keyed by the name used in the login routes.
+func (c *Config) SocialProviders() []social.Config {
+	providers := make([]social.Config, 0, len(c.Social.Providers))
+	for name, p := range c.Social.Providers {
+		providers = append(providers, social.Config{
+			Name:         name,
+			Type:         p.Type,
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectURL,
+			Scopes:       p.Scopes,
+			Issuer:       p.Issuer,
+			Tenant:       p.Tenant,
+			AuthURL:      p.AuthURL,
+			TokenURL:     p.TokenURL,
+			APIURL:       p.APIURL,
+		})
 	}
+
+	return providers
 }
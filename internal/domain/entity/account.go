@@ -13,6 +13,7 @@ import (
 const updateUsernameCooldown = 14 * 24 * time.Hour
 const updatePasswordCooldown = 30 * 24 * time.Hour
 const updateEmailCooldown = 30 * 24 * time.Hour
+const resendEmailVerificationCooldown = 1 * time.Minute
 
 const (
 	AccountStatusActive      = "active"
@@ -20,6 +21,13 @@ const (
 	AccountStatusSuspended   = "suspended"
 )
 
+// Sources of a new account, an account provisioned on a social login has the name of the
+// login provider as its source.
+const (
+	AccountSourceRegistration = "registration"
+	AccountSourceAdmin        = "admin"
+)
+
 var accountStatuses = []string{
 	AccountStatusActive,
 	AccountStatusDeactivated,
@@ -53,6 +61,13 @@ type Account struct {
 	UsernameUpdatedAt time.Time `json:"username_name_updated_at"`
 }
 
+type AccountsCollection struct {
+	Data  []Account `json:"data"`
+	Page  int32     `json:"page"`
+	Size  int32     `json:"size"`
+	Total int64     `json:"total"`
+}
+
 func (a Account) IsNil() bool {
 	return a.ID == uuid.Nil
 }
@@ -99,6 +114,12 @@ func (ap AccountPassword) CanChangePassword() error {
 }
 
 func (ap AccountPassword) CheckPasswordMatch(password string) error {
+	if ap.Hash == "" {
+		return errx.ErrorPasswordInvalid.Raise(
+			fmt.Errorf("account %s has no password", ap.AccountID),
+		)
+	}
+
 	if err := bcrypt.CompareHashAndPassword([]byte(ap.Hash), []byte(password)); err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
 			return errx.ErrorPasswordInvalid.Raise(
@@ -120,6 +141,9 @@ type AccountEmail struct {
 	Verified  bool      `json:"verified"`
 	UpdatedAt time.Time `json:"updated_at"`
 	CreatedAt time.Time `json:"created_at"`
+
+	VerificationSentAt *time.Time `json:"verification_sent_at,omitempty"`
+	EmailChangedAt     *time.Time `json:"email_changed_at,omitempty"`
 }
 
 func (ae AccountEmail) IsNil() bool {
@@ -127,7 +151,7 @@ func (ae AccountEmail) IsNil() bool {
 }
 
 func (ae AccountEmail) CanChangeEmail() error {
-	if time.Since(ae.UpdatedAt) >= updateEmailCooldown {
+	if ae.EmailChangedAt == nil || time.Since(*ae.EmailChangedAt) >= updateEmailCooldown {
 		return nil
 	}
 
@@ -145,3 +169,19 @@ func (ae AccountEmail) IsVerified() error {
 		"account with id %s has unverified email", ae.AccountID),
 	)
 }
+
+func (ae AccountEmail) CanResendVerification() error {
+	if ae.Verified {
+		return errx.ErrorEmailAlreadyVerified.Raise(fmt.Errorf(
+			"account with id %s has already verified email", ae.AccountID),
+		)
+	}
+
+	if ae.VerificationSentAt == nil || time.Since(*ae.VerificationSentAt) >= resendEmailVerificationCooldown {
+		return nil
+	}
+
+	return errx.ErrorCannotResendVerificationYet.Raise(fmt.Errorf(
+		"account with id %s cannot resend email verification yet", ae.AccountID),
+	)
+}
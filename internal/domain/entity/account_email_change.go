@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+type AccountEmailChange struct {
+	AccountID uuid.UUID `json:"account_id"`
+	NewEmail  string    `json:"new_email"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (c AccountEmailChange) IsNil() bool {
+	return c.AccountID == uuid.Nil
+}
+
+func (c AccountEmailChange) CanBeConfirmed() error {
+	if time.Now().UTC().After(c.ExpiresAt) {
+		return errx.ErrorEmailChangeExpired.Raise(fmt.Errorf(
+			"email change for account %s expired at %s", c.AccountID, c.ExpiresAt),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountIdentity links the account to a user of an external login provider, the
+// provider and subject pair identifies that user no matter which email it has.
+type AccountIdentity struct {
+	ID        uuid.UUID `json:"id"`
+	AccountID uuid.UUID `json:"account_id"`
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email"`
+	LinkedAt  time.Time `json:"linked_at"`
+}
+
+func (i AccountIdentity) IsNil() bool {
+	return i.ID == uuid.Nil
+}
@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+const MFARecoveryCodesCount = 10
+
+// MFAChallengeMaxAttempts is how many codes can be checked against one challenge, the
+// user has to log in with the password again after that.
+const MFAChallengeMaxAttempts = 5
+
+type AccountMFA struct {
+	AccountID    uuid.UUID `json:"account_id"`
+	TOTPSecret   string    `json:"-"`
+	Enabled      bool      `json:"enabled"`
+	TOTPLastStep int64     `json:"-"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (m AccountMFA) IsNil() bool {
+	return m.AccountID == uuid.Nil
+}
+
+func (m AccountMFA) IsEnabled() bool {
+	return !m.IsNil() && m.Enabled
+}
+
+func (m AccountMFA) CanBeEnabled() error {
+	if m.IsNil() {
+		return errx.ErrorMFANotSetUp.Raise(fmt.Errorf(
+			"account has no pending totp setup"),
+		)
+	}
+
+	if m.Enabled {
+		return errx.ErrorMFAAlreadyEnabled.Raise(fmt.Errorf(
+			"account with id %s already has mfa enabled", m.AccountID),
+		)
+	}
+
+	return nil
+}
+
+type TOTPSetup struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// MFAChallenge is issued by a password login to an account with mfa enabled. It is
+// stored to count the codes checked against it and deleted once a code is accepted.
+type MFAChallenge struct {
+	ID        uuid.UUID `json:"-"`
+	AccountID uuid.UUID `json:"-"`
+	Token     string    `json:"token"`
+	Attempts  int32     `json:"-"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"-"`
+}
+
+func (c MFAChallenge) IsNil() bool {
+	return c.ID == uuid.Nil
+}
+
+func (c MFAChallenge) CanBeAnswered(accountID uuid.UUID) error {
+	if c.IsNil() {
+		return errx.ErrorMFAChallengeInvalid.Raise(
+			fmt.Errorf("mfa challenge not found, it is unknown, used or out of attempts"),
+		)
+	}
+
+	if c.AccountID != accountID {
+		return errx.ErrorMFAChallengeInvalid.Raise(
+			fmt.Errorf("mfa challenge %s was issued for another account", c.ID),
+		)
+	}
+
+	if time.Now().UTC().After(c.ExpiresAt) {
+		return errx.ErrorMFAChallengeInvalid.Raise(
+			fmt.Errorf("mfa challenge %s expired at %s", c.ID, c.ExpiresAt),
+		)
+	}
+
+	return nil
+}
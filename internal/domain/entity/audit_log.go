@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionAccountRegisteredByAdmin = "account.registered_by_admin"
+	AuditActionAccountDeleted           = "account.deleted"
+	AuditActionAccountStatusChanged     = "account.status_changed"
+	AuditActionAccountRoleChanged       = "account.role_changed"
+	AuditActionPasswordChanged          = "account.password_changed"
+	AuditActionPasswordReset            = "account.password_reset"
+	AuditActionUsernameChanged          = "account.username_changed"
+	AuditActionEmailChanged             = "account.email_changed"
+	AuditActionTOTPEnabled              = "mfa.totp_enabled"
+	AuditActionTOTPDisabled             = "mfa.totp_disabled"
+	AuditActionPasskeyRegistered        = "passkey.registered"
+	AuditActionPasskeyDeleted           = "passkey.deleted"
+	AuditActionIdentityLinked           = "identity.linked"
+	AuditActionIdentityUnlinked         = "identity.unlinked"
+	AuditActionSessionLogout            = "session.logout"
+	AuditActionSessionDeleted           = "session.deleted"
+	AuditActionSessionsDeleted          = "session.deleted_all"
+	AuditActionSessionsRevoked          = "session.revoked_by_filter"
+	AuditActionSessionEvicted           = "session.evicted"
+	AuditActionSessionCompromised       = "session.compromised"
+)
+
+// AuditLogEntry records a security relevant change. ActorID is nil when the change was
+// not made by a logged in account, like a password reset, TargetID is nil when the
+// change is not about one account.
+type AuditLogEntry struct {
+	ID        uuid.UUID       `json:"id"`
+	ActorID   uuid.UUID       `json:"actor_id"`
+	TargetID  uuid.UUID       `json:"target_id"`
+	Action    string          `json:"action"`
+	IP        string          `json:"ip"`
+	UserAgent string          `json:"user_agent"`
+	Metadata  json.RawMessage `json:"metadata"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// AuditLogPage is one page of the audit log, newest entries first. NextCursor is nil on
+// the last page.
+type AuditLogPage struct {
+	Data       []AuditLogEntry `json:"data"`
+	NextCursor uuid.UUID       `json:"next_cursor"`
+	Limit      uint64          `json:"limit"`
+}
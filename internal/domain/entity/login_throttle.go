@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+const (
+	LoginThrottleAccount = "account"
+	LoginThrottleIP      = "ip"
+)
+
+// LoginLockoutPolicy describes when failed password attempts lock a login subject.
+// MaxAttempts failures within Window lock it for BaseLockout, every following lockout
+// doubles up to MaxLockout. The doubling starts over after ResetAfter without failures.
+type LoginLockoutPolicy struct {
+	MaxAttempts int32
+	Window      time.Duration
+	BaseLockout time.Duration
+	MaxLockout  time.Duration
+	ResetAfter  time.Duration
+}
+
+func (p LoginLockoutPolicy) IsEnabled() bool {
+	return p.MaxAttempts > 0
+}
+
+// LoginThrottle counts failed password attempts for an account or a client ip.
+type LoginThrottle struct {
+	Kind           string     `json:"kind"`
+	Subject        string     `json:"subject"`
+	FailedAttempts int32      `json:"failed_attempts"`
+	Lockouts       int32      `json:"lockouts"`
+	LockedUntil    *time.Time `json:"locked_until,omitempty"`
+	LastFailedAt   time.Time  `json:"last_failed_at"`
+}
+
+func (t LoginThrottle) IsNil() bool {
+	return t.Subject == ""
+}
+
+func (t LoginThrottle) IsLocked(now time.Time) bool {
+	return t.LockedUntil != nil && now.Before(*t.LockedUntil)
+}
+
+func (t LoginThrottle) CanAttemptLogin(now time.Time) error {
+	if t.IsLocked(now) {
+		return errx.ErrorAccountTemporarilyLocked.Raise(fmt.Errorf(
+			"login for %s %s is locked until %s", t.Kind, t.Subject, t.LockedUntil.Format(time.RFC3339)),
+		)
+	}
+
+	return nil
+}
+
+// RegisterFailure returns the throttle state after one more failed attempt at now.
+func (t LoginThrottle) RegisterFailure(now time.Time, policy LoginLockoutPolicy) LoginThrottle {
+	switch {
+	case t.IsNil() || now.Sub(t.LastFailedAt) > policy.ResetAfter:
+		t.FailedAttempts = 0
+		t.Lockouts = 0
+	case now.Sub(t.LastFailedAt) > policy.Window || t.FailedAttempts >= policy.MaxAttempts:
+		// the window is over or the previous failure already led to a lockout
+		t.FailedAttempts = 0
+	}
+
+	t.FailedAttempts++
+	t.LastFailedAt = now
+
+	if t.FailedAttempts >= policy.MaxAttempts {
+		lockout := policy.BaseLockout
+		for i := int32(0); i < t.Lockouts && lockout < policy.MaxLockout; i++ {
+			lockout *= 2
+		}
+		if lockout > policy.MaxLockout {
+			lockout = policy.MaxLockout
+		}
+
+		lockedUntil := now.Add(lockout)
+		t.LockedUntil = &lockedUntil
+		t.Lockouts++
+	}
+
+	return t
+}
@@ -0,0 +1,194 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+const (
+	OAuthScopeOpenID        = "openid"
+	OAuthScopeProfile       = "profile"
+	OAuthScopeEmail         = "email"
+	OAuthScopeOfflineAccess = "offline_access"
+)
+
+var OAuthScopes = []string{OAuthScopeOpenID, OAuthScopeProfile, OAuthScopeEmail, OAuthScopeOfflineAccess}
+
+const PKCEMethodS256 = "S256"
+
+// OAuthClient is an application registered to log its users in through the openid
+// connect endpoints. Public clients have no secret and rely on pkce alone.
+type OAuthClient struct {
+	ID           string    `json:"id"`
+	SecretHash   string    `json:"-"`
+	Name         string    `json:"name"`
+	RedirectURIs []string  `json:"redirect_uris"`
+	Scopes       []string  `json:"scopes"`
+	SkipConsent  bool      `json:"skip_consent"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func (c OAuthClient) IsNil() bool {
+	return c.ID == ""
+}
+
+func (c OAuthClient) IsPublic() bool {
+	return c.SecretHash == ""
+}
+
+// CanRedirectTo reports whether uri is one of the registered redirect uris, compared
+// exactly as required by the oauth security best practices.
+func (c OAuthClient) CanRedirectTo(uri string) error {
+	if !slices.Contains(c.RedirectURIs, uri) {
+		return errx.ErrorOAuthRedirectURIInvalid.Raise(fmt.Errorf(
+			"redirect uri %q is not registered for oauth client %s", uri, c.ID),
+		)
+	}
+
+	return nil
+}
+
+func (c OAuthClient) CanRequestScopes(scopes []string) error {
+	if !slices.Contains(scopes, OAuthScopeOpenID) {
+		return errx.ErrorOAuthScopeInvalid.Raise(fmt.Errorf(
+			"scope %q is required", OAuthScopeOpenID),
+		)
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(c.Scopes, scope) {
+			return errx.ErrorOAuthScopeInvalid.Raise(fmt.Errorf(
+				"scope %q is not allowed for oauth client %s", scope, c.ID),
+			)
+		}
+	}
+
+	return nil
+}
+
+// OAuthAuthorizationCode is the single use grant handed to the client after the user
+// approved an authorization request. Only the hash of the code is stored.
+type OAuthAuthorizationCode struct {
+	CodeHash            string    `json:"-"`
+	ClientID            string    `json:"client_id"`
+	AccountID           uuid.UUID `json:"account_id"`
+	RedirectURI         string    `json:"redirect_uri"`
+	Scopes              []string  `json:"scopes"`
+	Nonce               string    `json:"nonce"`
+	CodeChallenge       string    `json:"code_challenge"`
+	CodeChallengeMethod string    `json:"code_challenge_method"`
+	AuthTime            time.Time `json:"auth_time"`
+	ExpiresAt           time.Time `json:"expires_at"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+func (c OAuthAuthorizationCode) IsNil() bool {
+	return c.CodeHash == ""
+}
+
+// CanBeExchanged checks the code against the token request, codeVerifier must be the
+// pkce verifier whose S256 challenge was sent to the authorization endpoint.
+func (c OAuthAuthorizationCode) CanBeExchanged(clientID, redirectURI, codeVerifier string) error {
+	if c.IsNil() || c.ClientID != clientID {
+		return errx.ErrorOAuthGrantInvalid.Raise(fmt.Errorf(
+			"authorization code not found for oauth client %s", clientID),
+		)
+	}
+
+	if time.Now().UTC().After(c.ExpiresAt) {
+		return errx.ErrorOAuthGrantInvalid.Raise(fmt.Errorf(
+			"authorization code expired at %s", c.ExpiresAt),
+		)
+	}
+
+	if c.RedirectURI != redirectURI {
+		return errx.ErrorOAuthGrantInvalid.Raise(fmt.Errorf(
+			"redirect uri %q does not match the authorization request", redirectURI),
+		)
+	}
+
+	sum := sha256.Sum256([]byte(codeVerifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	if c.CodeChallengeMethod != PKCEMethodS256 ||
+		subtle.ConstantTimeCompare([]byte(challenge), []byte(c.CodeChallenge)) != 1 {
+		return errx.ErrorOAuthGrantInvalid.Raise(fmt.Errorf(
+			"code verifier does not match the code challenge"),
+		)
+	}
+
+	return nil
+}
+
+type OAuthConsent struct {
+	AccountID uuid.UUID `json:"account_id"`
+	ClientID  string    `json:"client_id"`
+	Scopes    []string  `json:"scopes"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (c OAuthConsent) IsNil() bool {
+	return c.AccountID == uuid.Nil
+}
+
+// Covers reports whether the user has already agreed to share every one of the scopes.
+func (c OAuthConsent) Covers(scopes []string) bool {
+	if c.IsNil() {
+		return false
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(c.Scopes, scope) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// OAuthTokens is the result of a token request, the account tokens of the session plus
+// the id token for the client.
+type OAuthTokens struct {
+	TokensPair
+	IDToken string   `json:"id_token,omitempty"`
+	Scopes  []string `json:"scopes"`
+}
+
+const (
+	TokenTypeAccess  = "access_token"
+	TokenTypeRefresh = "refresh_token"
+)
+
+// TokenIntrospection tells whether a token is backed by a live session. Every field but
+// Active is left empty for inactive tokens, so nothing leaks about them.
+type TokenIntrospection struct {
+	Active    bool      `json:"active"`
+	TokenType string    `json:"token_type,omitempty"`
+	AccountID uuid.UUID `json:"account_id,omitempty"`
+	SessionID uuid.UUID `json:"session_id,omitempty"`
+	Role      string    `json:"role,omitempty"`
+	Username  string    `json:"username,omitempty"`
+	Issuer    string    `json:"issuer,omitempty"`
+	IssuedAt  time.Time `json:"issued_at,omitempty"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	ClientID  string    `json:"client_id,omitempty"`
+	Scopes    []string  `json:"scopes,omitempty"`
+}
+
+// UserInfo holds the claims about the user a client may read. Username is set with the
+// profile scope, Email and EmailVerified with the email scope.
+type UserInfo struct {
+	Subject       uuid.UUID `json:"subject"`
+	Username      string    `json:"username,omitempty"`
+	Email         string    `json:"email,omitempty"`
+	EmailVerified *bool     `json:"email_verified,omitempty"`
+}
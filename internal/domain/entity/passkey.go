@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+const (
+	PasskeyCeremonyRegistration = "registration"
+	PasskeyCeremonyLogin        = "login"
+)
+
+type Passkey struct {
+	ID              uuid.UUID  `json:"id"`
+	AccountID       uuid.UUID  `json:"account_id"`
+	CredentialID    []byte     `json:"credential_id"`
+	PublicKey       []byte     `json:"-"`
+	AttestationType string     `json:"attestation_type"`
+	Transports      []string   `json:"transports"`
+	SignCount       uint32     `json:"sign_count"`
+	AAGUID          []byte     `json:"aaguid"`
+	BackupEligible  bool       `json:"backup_eligible"`
+	BackupState     bool       `json:"backup_state"`
+	Name            string     `json:"name"`
+	LastUsedAt      *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+func (p Passkey) IsNil() bool {
+	return p.ID == uuid.Nil
+}
+
+// PasskeyCeremony is the server side state of a WebAuthn registration or login between
+// its begin and finish steps. Options are sent to the client as is.
+type PasskeyCeremony struct {
+	ID        uuid.UUID       `json:"id"`
+	AccountID uuid.UUID       `json:"account_id"`
+	Kind      string          `json:"kind"`
+	Options   json.RawMessage `json:"options"`
+	Session   []byte          `json:"-"`
+	ExpiresAt time.Time       `json:"expires_at"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func (c PasskeyCeremony) IsNil() bool {
+	return c.ID == uuid.Nil
+}
+
+func (c PasskeyCeremony) CanBeFinished(kind string, accountID uuid.UUID) error {
+	if c.IsNil() || c.Kind != kind || c.AccountID != accountID {
+		return errx.ErrorPasskeyCeremonyNotFound.Raise(fmt.Errorf(
+			"%s passkey ceremony %s not found", kind, c.ID),
+		)
+	}
+
+	if time.Now().UTC().After(c.ExpiresAt) {
+		return errx.ErrorPasskeyCeremonyNotFound.Raise(fmt.Errorf(
+			"passkey ceremony %s expired at %s", c.ID, c.ExpiresAt),
+		)
+	}
+
+	return nil
+}
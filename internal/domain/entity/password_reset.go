@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+const PasswordResetTokenLifetime = 30 * time.Minute
+
+type PasswordResetToken struct {
+	ID        uuid.UUID  `json:"id"`
+	AccountID uuid.UUID  `json:"account_id"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func (t PasswordResetToken) IsNil() bool {
+	return t.ID == uuid.Nil
+}
+
+func (t PasswordResetToken) CanBeUsed() error {
+	if t.UsedAt != nil {
+		return errx.ErrorPasswordResetTokenInvalid.Raise(fmt.Errorf(
+			"password reset token %s has already been used", t.ID),
+		)
+	}
+
+	if time.Now().UTC().After(t.ExpiresAt) {
+		return errx.ErrorPasswordResetTokenExpired.Raise(fmt.Errorf(
+			"password reset token %s expired at %s", t.ID, t.ExpiresAt),
+		)
+	}
+
+	return nil
+}
@@ -1,22 +1,108 @@
 package entity
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/errx"
 )
 
+const (
+	SessionExpiredIdle     = "idle"
+	SessionExpiredAbsolute = "absolute"
+)
+
+// SessionExpiry limits the life of a session. IdleTimeout ends a session not refreshed for
+// that long, AbsoluteLifetime ends it that long after the login. Zero does not limit.
+type SessionExpiry struct {
+	IdleTimeout      time.Duration
+	AbsoluteLifetime time.Duration
+}
+
+const (
+	SessionLimitReject   = "reject"
+	SessionLimitEvictLRU = "evict_lru"
+)
+
+// SessionLimitPolicy caps the sessions an account holds at once by its role, roles not
+// listed are not capped. OnLimit decides what a login past the cap does, SessionLimitReject
+// refuses it and SessionLimitEvictLRU ends the least recently used sessions to make room.
+type SessionLimitPolicy struct {
+	MaxPerRole map[string]int
+	OnLimit    string
+}
+
+// Admit checks if one more session fits next to the given ones, which must be ordered
+// from the least recently used. It returns the sessions to end before the new one opens.
+func (p SessionLimitPolicy) Admit(account Account, sessions []Session) ([]Session, error) {
+	limit, ok := p.MaxPerRole[account.Role]
+	if !ok || limit <= 0 || len(sessions) < limit {
+		return nil, nil
+	}
+
+	if p.OnLimit != SessionLimitEvictLRU {
+		return nil, errx.ErrorSessionsLimitReached.Raise(
+			fmt.Errorf("account %s already has %d of %d sessions allowed for role %s",
+				account.ID, len(sessions), limit, account.Role),
+		)
+	}
+
+	return sessions[:len(sessions)-limit+1], nil
+}
+
 type Session struct {
-	ID        uuid.UUID `json:"id"`
-	AccountID uuid.UUID `json:"account_id"`
-	LastUsed  time.Time `json:"last_used"`
-	CreatedAt time.Time `json:"created_at"`
+	ID         uuid.UUID `json:"id"`
+	AccountID  uuid.UUID `json:"account_id"`
+	Generation int64     `json:"generation"`
+	LastUsed   time.Time `json:"last_used"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	SessionClient
+}
+
+// SessionClient describes the client a session was opened from, so users can tell their
+// sessions apart. IP is the address of the last refresh. OAuthClientID is the oauth
+// client the session was opened for, empty for the sessions of our own front-ends.
+type SessionClient struct {
+	IP         string `json:"ip"`
+	UserAgent  string `json:"user_agent"`
+	Device     string `json:"device"`
+	OS         string `json:"os"`
+	Browser    string `json:"browser"`
+	ClientName string `json:"client_name"`
+	GeoHint    string `json:"geo_hint"`
+
+	OAuthClientID string `json:"oauth_client_id,omitempty"`
 }
 
 func (s Session) IsNil() bool {
 	return s.ID == uuid.Nil
 }
 
+// ExpiredBy returns why the session has expired at the given time, SessionExpiredIdle or
+// SessionExpiredAbsolute, or an empty string for a session that has not.
+func (s Session) ExpiredBy(expiry SessionExpiry, now time.Time) string {
+	switch {
+	case expiry.AbsoluteLifetime > 0 && !now.Before(s.CreatedAt.Add(expiry.AbsoluteLifetime)):
+		return SessionExpiredAbsolute
+	case expiry.IdleTimeout > 0 && !now.Before(s.LastUsed.Add(expiry.IdleTimeout)):
+		return SessionExpiredIdle
+	default:
+		return ""
+	}
+}
+
+func (s Session) CanBeUsed(expiry SessionExpiry) error {
+	if reason := s.ExpiredBy(expiry, time.Now().UTC()); reason != "" {
+		return errx.ErrorSessionExpired.Raise(
+			fmt.Errorf("session %s has expired, %s limit reached", s.ID, reason),
+		)
+	}
+
+	return nil
+}
+
 type SessionsCollection struct {
 	Data  []Session `json:"repo"`
 	Page  int32     `json:"page"`
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+)
+
+const (
+	SigningKeyStatusActive   = "active"
+	SigningKeyStatusRetiring = "retiring"
+)
+
+// SigningKey is an asymmetric key used to sign account tokens. Active keys sign new
+// tokens, retiring keys are only kept to verify tokens issued before a rotation
+// until ExpiresAt. PrivateKey is stored encrypted.
+type SigningKey struct {
+	ID         string     `json:"id"`
+	Algorithm  string     `json:"algorithm"`
+	PrivateKey string     `json:"-"`
+	Status     string     `json:"status"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func (k SigningKey) IsNil() bool {
+	return k.ID == ""
+}
+
+func (k SigningKey) IsActive() bool {
+	return k.Status == SigningKeyStatusActive
+}
@@ -0,0 +1,12 @@
+package entity
+
+// SocialIdentity is the user as described by an external login provider, normalized
+// from the provider specific id token or userinfo response.
+type SocialIdentity struct {
+	Provider      string `json:"provider"`
+	Subject       string `json:"subject"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	Username      string `json:"username"`
+}
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+const (
+	SocialLoginPurposeLogin = "login"
+	SocialLoginPurposeLink  = "link"
+)
+
+// SocialLoginState is the server side state of a login with an external provider between
+// the redirect to the provider and its callback. Only the hash of the state is stored,
+// the state itself travels through the provider.
+type SocialLoginState struct {
+	State     string    `json:"-"`
+	StateHash string    `json:"-"`
+	Provider  string    `json:"provider"`
+	Purpose   string    `json:"purpose"`
+	AccountID uuid.UUID `json:"account_id"`
+	SessionID uuid.UUID `json:"session_id"`
+	// CodeVerifier is the PKCE verifier whose challenge was sent to the provider.
+	CodeVerifier string `json:"-"`
+	// Nonce is the value the provider must copy into the id token.
+	Nonce string `json:"-"`
+	// RedirectURI is where the user agent is sent after the callback, empty to render
+	// the result instead.
+	RedirectURI string    `json:"redirect_uri"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (s SocialLoginState) IsNil() bool {
+	return s.StateHash == ""
+}
+
+func (s SocialLoginState) CanBeConsumed(provider string) error {
+	if s.IsNil() {
+		return errx.ErrorSocialStateInvalid.Raise(
+			fmt.Errorf("login state not found, it is unknown or was already used"),
+		)
+	}
+
+	if s.Provider != provider {
+		return errx.ErrorSocialStateInvalid.Raise(
+			fmt.Errorf("login state was issued for %s, not %s", s.Provider, provider),
+		)
+	}
+
+	if time.Now().UTC().After(s.ExpiresAt) {
+		return errx.ErrorSocialStateInvalid.Raise(
+			fmt.Errorf("login state expired at %s", s.ExpiresAt),
+		)
+	}
+
+	return nil
+}
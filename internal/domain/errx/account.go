@@ -8,6 +8,7 @@ var ErrorAccountNotFound = ape.DeclareError("ACCOUNT_NOT_FOUND")
 var ErrorAccountIsNotActive = ape.DeclareError("ACCOUNT_IS_NOT_ACTIVE")
 var ErrorAccountIsBlocked = ape.DeclareError("ACCOUNT_IS_BLOCKED")
 var ErrorAccountInvalidSession = ape.DeclareError("ACCOUNT_INVALID_SESSION")
+var ErrorAccountTemporarilyLocked = ape.DeclareError("ACCOUNT_TEMPORARILY_LOCKED")
 
 var ErrorInitiatorIsNotActive = ape.DeclareError("INITIATOR_IS_NOT_ACTIVE")
 var ErrorInitiatorNotFound = ape.DeclareError("INITIATOR_NOT_FOUND")
@@ -16,6 +17,11 @@ var ErrorInitiatorInvalidSession = ape.DeclareError("INITIATOR_INVALID_SESSION")
 var ErrorEmailAlreadyExist = ape.DeclareError("EMAIL_ALREADY_EXIST")
 var ErrorEmailNotVerified = ape.DeclareError("EMAIL_NOT_VERIFIED")
 var ErrorCannotChangeEmailYet = ape.DeclareError("CANNOT_CHANGE_EMAIL_YET")
+var ErrorEmailAlreadyVerified = ape.DeclareError("EMAIL_ALREADY_VERIFIED")
+var ErrorEmailVerificationCodeInvalid = ape.DeclareError("EMAIL_VERIFICATION_CODE_INVALID")
+var ErrorCannotResendVerificationYet = ape.DeclareError("CANNOT_RESEND_VERIFICATION_YET")
+var ErrorEmailChangeNotFound = ape.DeclareError("EMAIL_CHANGE_NOT_FOUND")
+var ErrorEmailChangeExpired = ape.DeclareError("EMAIL_CHANGE_EXPIRED")
 
 var ErrorPasswordInvalid = ape.DeclareError("PASSWORD_INVALID")
 var ErrorPasswordIsNotAllowed = ape.DeclareError("PASSWORD_IS_NOT_ALLOWED")
@@ -26,3 +32,5 @@ var ErrorUsernameAlreadyTaken = ape.DeclareError("USERNAME_ALREADY_TAKEN")
 var ErrorCannotChangeUsernameYet = ape.DeclareError("CANNOT_CHANGE_USERNAME_YET")
 
 var ErrorRoleNotSupported = ape.DeclareError("ACCOUNT_ROLE_NOT_SUPPORTED")
+var ErrorStatusNotSupported = ape.DeclareError("ACCOUNT_STATUS_NOT_SUPPORTED")
+var ErrorCannotManageOwnAccount = ape.DeclareError("CANNOT_MANAGE_OWN_ACCOUNT")
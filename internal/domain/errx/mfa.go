@@ -0,0 +1,11 @@
+package errx
+
+import (
+	"github.com/umisto/ape"
+)
+
+var ErrorMFANotEnabled = ape.DeclareError("MFA_NOT_ENABLED")
+var ErrorMFAAlreadyEnabled = ape.DeclareError("MFA_ALREADY_ENABLED")
+var ErrorMFANotSetUp = ape.DeclareError("MFA_NOT_SET_UP")
+var ErrorMFACodeInvalid = ape.DeclareError("MFA_CODE_INVALID")
+var ErrorMFAChallengeInvalid = ape.DeclareError("MFA_CHALLENGE_INVALID")
@@ -0,0 +1,19 @@
+package errx
+
+import (
+	"github.com/umisto/ape"
+)
+
+var ErrorOAuthClientNotFound = ape.DeclareError("OAUTH_CLIENT_NOT_FOUND")
+
+var ErrorOAuthClientUnauthorized = ape.DeclareError("OAUTH_CLIENT_UNAUTHORIZED")
+
+var ErrorOAuthRedirectURIInvalid = ape.DeclareError("OAUTH_REDIRECT_URI_INVALID")
+
+var ErrorOAuthRequestInvalid = ape.DeclareError("OAUTH_REQUEST_INVALID")
+
+var ErrorOAuthScopeInvalid = ape.DeclareError("OAUTH_SCOPE_INVALID")
+
+var ErrorOAuthConsentRequired = ape.DeclareError("OAUTH_CONSENT_REQUIRED")
+
+var ErrorOAuthGrantInvalid = ape.DeclareError("OAUTH_GRANT_INVALID")
@@ -0,0 +1,9 @@
+package errx
+
+import (
+	"github.com/umisto/ape"
+)
+
+var ErrorPasskeyNotFound = ape.DeclareError("PASSKEY_NOT_FOUND")
+var ErrorPasskeyInvalid = ape.DeclareError("PASSKEY_INVALID")
+var ErrorPasskeyCeremonyNotFound = ape.DeclareError("PASSKEY_CEREMONY_NOT_FOUND")
@@ -0,0 +1,8 @@
+package errx
+
+import (
+	"github.com/umisto/ape"
+)
+
+var ErrorPasswordResetTokenInvalid = ape.DeclareError("PASSWORD_RESET_TOKEN_INVALID")
+var ErrorPasswordResetTokenExpired = ape.DeclareError("PASSWORD_RESET_TOKEN_EXPIRED")
@@ -7,3 +7,9 @@ import (
 var ErrorSessionNotFound = ape.DeclareError("SESSION_NOT_FOUND")
 
 var ErrorSessionTokenMismatch = ape.DeclareError("SESSION_TOKEN_MISMATCH")
+
+var ErrorSessionsFilterRequired = ape.DeclareError("SESSIONS_FILTER_REQUIRED")
+
+var ErrorSessionExpired = ape.DeclareError("SESSION_EXPIRED")
+
+var ErrorSessionsLimitReached = ape.DeclareError("SESSIONS_LIMIT_REACHED")
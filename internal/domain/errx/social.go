@@ -0,0 +1,11 @@
+package errx
+
+import (
+	"github.com/umisto/ape"
+)
+
+var ErrorSocialEmailNotVerified = ape.DeclareError("SOCIAL_EMAIL_NOT_VERIFIED")
+var ErrorAccountIdentityNotFound = ape.DeclareError("ACCOUNT_IDENTITY_NOT_FOUND")
+var ErrorAccountIdentityAlreadyLinked = ape.DeclareError("ACCOUNT_IDENTITY_ALREADY_LINKED")
+var ErrorSocialStateInvalid = ape.DeclareError("SOCIAL_STATE_INVALID")
+var ErrorSocialRedirectURINotAllowed = ape.DeclareError("SOCIAL_REDIRECT_URI_NOT_ALLOWED")
@@ -0,0 +1,216 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+// LinkOwnIdentity links the provider user to the account of the initiator. The initiator
+// just proved control of the provider account, so the email need not be verified.
+func (s Service) LinkOwnIdentity(
+	ctx context.Context,
+	initiator InitiatorData,
+	identity entity.SocialIdentity,
+) (entity.AccountIdentity, error) {
+	_, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return entity.AccountIdentity{}, err
+	}
+
+	linked, err := s.getAccountIdentityBySubject(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		return entity.AccountIdentity{}, err
+	}
+	if !linked.IsNil() {
+		if linked.AccountID != initiator.AccountID {
+			return entity.AccountIdentity{}, errx.ErrorAccountIdentityAlreadyLinked.Raise(
+				fmt.Errorf("%s identity %s is linked to another account", identity.Provider, identity.Subject),
+			)
+		}
+
+		return linked, nil
+	}
+
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		linked, err = s.createAccountIdentity(ctx, initiator.AccountID, initiator.AccountID, identity)
+		return err
+	})
+	if err != nil {
+		return entity.AccountIdentity{}, err
+	}
+
+	return linked, nil
+}
+
+func (s Service) GetOwnIdentities(ctx context.Context, initiator InitiatorData) ([]entity.AccountIdentity, error) {
+	_, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := s.db.GetAccountIdentities(ctx, initiator.AccountID)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get identities for account %s, cause: %w", initiator.AccountID, err),
+		)
+	}
+
+	return identities, nil
+}
+
+func (s Service) UnlinkOwnIdentity(ctx context.Context, initiator InitiatorData, identityID uuid.UUID) error {
+	_, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return err
+	}
+
+	identity, err := s.db.GetAccountIdentity(ctx, initiator.AccountID, identityID)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get identity %s for account %s, cause: %w", identityID, initiator.AccountID, err),
+		)
+	}
+	if identity.IsNil() {
+		return errx.ErrorAccountIdentityNotFound.Raise(
+			fmt.Errorf("identity %s not found for account %s", identityID, initiator.AccountID),
+		)
+	}
+
+	return s.UnitOfWork(ctx, func(ctx context.Context) error {
+		err = s.db.DeleteAccountIdentity(ctx, initiator.AccountID, identityID)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to delete identity %s for account %s, cause: %w", identityID, initiator.AccountID, err),
+			)
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionIdentityUnlinked, initiator.AccountID, initiator.AccountID, map[string]any{
+			"identity_id": identity.ID,
+			"provider":    identity.Provider,
+			"subject":     identity.Subject,
+		})
+	})
+}
+
+// linkSocialIdentity links an unknown provider subject to the account with the same
+// email, or provisions a new account when no such account exists and provisioning is on.
+// Both need the provider to have verified the email, linking also needs the account to
+// have verified it.
+func (s Service) linkSocialIdentity(ctx context.Context, identity entity.SocialIdentity) (entity.Account, error) {
+	if identity.Email == "" {
+		return entity.Account{}, errx.ErrorSocialEmailNotVerified.Raise(
+			fmt.Errorf("%s did not return an email for subject %s", identity.Provider, identity.Subject),
+		)
+	}
+
+	account, err := s.db.GetAccountByEmail(ctx, identity.Email)
+	if err != nil {
+		return entity.Account{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get account with email '%s', cause: %w", identity.Email, err),
+		)
+	}
+
+	// an unverified email neither proves the ownership of an account nor may reserve the
+	// email for a new one
+	if !identity.EmailVerified {
+		return entity.Account{}, errx.ErrorSocialEmailNotVerified.Raise(
+			fmt.Errorf("%s did not return a verified email for subject %s", identity.Provider, identity.Subject),
+		)
+	}
+
+	if account.IsNil() && s.cfg.Social.AutoProvision {
+		return s.provisionSocialAccount(ctx, identity)
+	}
+
+	if account.IsNil() {
+		return entity.Account{}, errx.ErrorAccountNotFound.Raise(
+			fmt.Errorf("account with email '%s' not found", identity.Email),
+		)
+	}
+
+	if err = account.CanInteract(); err != nil {
+		return entity.Account{}, err
+	}
+
+	// whoever registered an unverified email may not own it, linking would leave them a
+	// way into the account of the owner, who has to link the identity after logging in
+	accountEmail, err := s.GetAccountEmail(ctx, account.ID)
+	if err != nil {
+		return entity.Account{}, err
+	}
+	if !accountEmail.Verified {
+		return entity.Account{}, errx.ErrorSocialEmailNotVerified.Raise(
+			fmt.Errorf("email of account %s is not verified, %s identity %s is not linked",
+				account.ID, identity.Provider, identity.Subject),
+		)
+	}
+
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		_, err = s.createAccountIdentity(ctx, account.ID, account.ID, identity)
+		return err
+	})
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	return account, nil
+}
+
+// createAccountIdentity links the provider subject to the account and audits the link,
+// it has to run inside a unit of work.
+func (s Service) createAccountIdentity(
+	ctx context.Context,
+	actorID, accountID uuid.UUID,
+	identity entity.SocialIdentity,
+) (entity.AccountIdentity, error) {
+	linked, err := s.db.CreateAccountIdentity(ctx, accountID, identity)
+	if err != nil {
+		return entity.AccountIdentity{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to link %s identity %s to account %s, cause: %w",
+				identity.Provider, identity.Subject, accountID, err),
+		)
+	}
+
+	err = s.writeAudit(ctx, entity.AuditActionIdentityLinked, actorID, accountID, map[string]any{
+		"identity_id": linked.ID,
+		"provider":    linked.Provider,
+		"subject":     linked.Subject,
+	})
+	if err != nil {
+		return entity.AccountIdentity{}, err
+	}
+
+	return linked, nil
+}
+
+// getLinkedAccount returns the account linked to the provider subject, or a nil account
+// when the subject is not linked yet.
+func (s Service) getLinkedAccount(ctx context.Context, identity entity.SocialIdentity) (entity.Account, error) {
+	linked, err := s.getAccountIdentityBySubject(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		return entity.Account{}, err
+	}
+	if linked.IsNil() {
+		return entity.Account{}, nil
+	}
+
+	return s.GetAccountByID(ctx, linked.AccountID)
+}
+
+func (s Service) getAccountIdentityBySubject(
+	ctx context.Context,
+	provider, subject string,
+) (entity.AccountIdentity, error) {
+	identity, err := s.db.GetAccountIdentityBySubject(ctx, provider, subject)
+	if err != nil {
+		return entity.AccountIdentity{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get %s identity %s, cause: %w", provider, subject, err),
+		)
+	}
+
+	return identity, nil
+}
@@ -0,0 +1,251 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/umisto/restkit/roles"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+// UpdateAccountByAdminParams holds the changes an admin makes to an account, nil fields
+// stay as they are. Reason is passed on with the change events.
+type UpdateAccountByAdminParams struct {
+	Status *string
+	Role   *string
+	Reason string
+}
+
+func (s Service) GetAccountsByAdmin(
+	ctx context.Context,
+	initiator InitiatorData,
+	filter AccountsFilter,
+	page, size int32,
+) (entity.AccountsCollection, error) {
+	if _, err := s.validateAdmin(ctx, initiator); err != nil {
+		return entity.AccountsCollection{}, err
+	}
+
+	accounts, err := s.db.GetAccounts(ctx, filter, page, size)
+	if err != nil {
+		return entity.AccountsCollection{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to select accounts, cause: %w", err),
+		)
+	}
+
+	return accounts, nil
+}
+
+func (s Service) GetAccountByAdmin(
+	ctx context.Context,
+	initiator InitiatorData,
+	accountID uuid.UUID,
+) (entity.Account, error) {
+	if _, err := s.validateAdmin(ctx, initiator); err != nil {
+		return entity.Account{}, err
+	}
+
+	return s.GetAccountByID(ctx, accountID)
+}
+
+// UpdateAccountByAdmin changes the status and role of another account. Both are checked
+// before either is written, and both changes commit together. Every session of an account
+// leaving the active status is revoked, so suspension takes effect at once.
+func (s Service) UpdateAccountByAdmin(
+	ctx context.Context,
+	initiator InitiatorData,
+	accountID uuid.UUID,
+	params UpdateAccountByAdminParams,
+) (entity.Account, error) {
+	if _, err := s.validateAdmin(ctx, initiator); err != nil {
+		return entity.Account{}, err
+	}
+
+	if accountID == initiator.AccountID {
+		return entity.Account{}, errx.ErrorCannotManageOwnAccount.Raise(
+			fmt.Errorf("admin %s cannot change the status or role of the own account", initiator.AccountID),
+		)
+	}
+
+	changeRole := params.Role != nil
+	if changeRole {
+		if err := checkAccountRole(*params.Role); err != nil {
+			return entity.Account{}, err
+		}
+	}
+
+	changeStatus := params.Status != nil
+	if changeStatus {
+		if err := checkAccountStatus(*params.Status); err != nil {
+			return entity.Account{}, err
+		}
+	}
+
+	account, err := s.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	email, err := s.GetAccountEmail(ctx, accountID)
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	changeRole = changeRole && *params.Role != account.Role
+	changeStatus = changeStatus && *params.Status != account.Status
+
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		if changeRole {
+			account, err = s.changeAccountRole(ctx, initiator, account, email.Email, *params.Role, params.Reason)
+			if err != nil {
+				return err
+			}
+		}
+
+		if changeStatus {
+			account, err = s.changeAccountStatus(ctx, initiator, account, email.Email, *params.Status, params.Reason)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Account{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to update account %s, cause: %w", accountID, err),
+		)
+	}
+
+	return account, nil
+}
+
+func checkAccountRole(role string) error {
+	if err := roles.ValidateUserSystemRole(role); err != nil {
+		return errx.ErrorRoleNotSupported.Raise(
+			fmt.Errorf("failed to parsing role %q, cause: %w", role, err),
+		)
+	}
+
+	return nil
+}
+
+func checkAccountStatus(status string) error {
+	if err := entity.CheckAccountStatus(status); err != nil {
+		return errx.ErrorStatusNotSupported.Raise(
+			fmt.Errorf("failed to parsing status %q, cause: %w", status, err),
+		)
+	}
+
+	return nil
+}
+
+func (s Service) updateAccountStatus(
+	ctx context.Context,
+	initiator InitiatorData,
+	account entity.Account,
+	email, status, reason string,
+) (entity.Account, error) {
+	if err := checkAccountStatus(status); err != nil {
+		return entity.Account{}, err
+	}
+
+	var updated entity.Account
+	err := s.UnitOfWork(ctx, func(ctx context.Context) (err error) {
+		updated, err = s.changeAccountStatus(ctx, initiator, account, email, status, reason)
+		return err
+	})
+	if err != nil {
+		return entity.Account{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to update status of account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	return updated, nil
+}
+
+// changeAccountRole writes the role change with its audit entry and event, call it
+// within a unit of work with a role already checked.
+func (s Service) changeAccountRole(
+	ctx context.Context,
+	initiator InitiatorData,
+	account entity.Account,
+	email, role, reason string,
+) (entity.Account, error) {
+	updated, err := s.db.UpdateAccountRole(ctx, account.ID, role)
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	err = s.writeAudit(ctx, entity.AuditActionAccountRoleChanged, initiator.AccountID, account.ID, map[string]any{
+		"old_role": account.Role,
+		"new_role": role,
+		"reason":   reason,
+	})
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	err = s.event.WriteAccountRoleChanged(ctx, updated, email, account.Role, reason, initiator.AccountID)
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	return updated, nil
+}
+
+// changeAccountStatus writes the status change with its audit entry and event, revoking
+// the sessions of an account leaving the active status. Call it within a unit of work
+// with a status already checked.
+func (s Service) changeAccountStatus(
+	ctx context.Context,
+	initiator InitiatorData,
+	account entity.Account,
+	email, status, reason string,
+) (entity.Account, error) {
+	updated, err := s.db.UpdateAccountStatus(ctx, account.ID, status)
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	if status != entity.AccountStatusActive {
+		if err = s.db.DeleteSessionsForAccount(ctx, account.ID); err != nil {
+			return entity.Account{}, err
+		}
+	}
+
+	err = s.writeAudit(ctx, entity.AuditActionAccountStatusChanged, initiator.AccountID, account.ID, map[string]any{
+		"old_status": account.Status,
+		"new_status": status,
+		"reason":     reason,
+	})
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	err = s.event.WriteAccountStatusChanged(ctx, updated, email, account.Status, reason, initiator.AccountID)
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	return updated, nil
+}
+
+// validateAdmin checks the session of the initiator and that it is a system admin. The
+// router checks the role of the token too, this catches a role revoked since it was issued.
+func (s Service) validateAdmin(ctx context.Context, initiator InitiatorData) (entity.Account, error) {
+	account, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	if account.Role != roles.SystemAdmin {
+		return entity.Account{}, errx.ErrorNotEnoughRights.Raise(
+			fmt.Errorf("account %s has insufficient permissions to manage accounts", initiator.AccountID),
+		)
+	}
+
+	return account, nil
+}
@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+func (s Service) GetAccountSessionsByAdmin(
+	ctx context.Context,
+	initiator InitiatorData,
+	accountID uuid.UUID,
+	page, size int32,
+) (entity.SessionsCollection, error) {
+	if _, err := s.validateAdmin(ctx, initiator); err != nil {
+		return entity.SessionsCollection{}, err
+	}
+
+	if _, err := s.GetAccountByID(ctx, accountID); err != nil {
+		return entity.SessionsCollection{}, err
+	}
+
+	sessions, err := s.db.GetSessionsForAccount(ctx, accountID, page, size)
+	if err != nil {
+		return entity.SessionsCollection{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to list sessions for account %s, cause: %w", accountID, err),
+		)
+	}
+
+	return sessions, nil
+}
+
+// DeleteAccountSessionByAdmin revokes one session of another account, the admin's own
+// sessions are managed through the own session endpoints.
+func (s Service) DeleteAccountSessionByAdmin(
+	ctx context.Context,
+	initiator InitiatorData,
+	accountID, sessionID uuid.UUID,
+) error {
+	if err := s.validateAdminOf(ctx, initiator, accountID); err != nil {
+		return err
+	}
+
+	session, err := s.db.GetAccountSession(ctx, accountID, sessionID)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get session with id: %s for account %s, cause: %w", sessionID, accountID, err),
+		)
+	}
+	if session.IsNil() {
+		return errx.ErrorSessionNotFound.Raise(
+			fmt.Errorf("session with id: %s for account %s not found", sessionID, accountID),
+		)
+	}
+
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		if err = s.db.DeleteAccountSession(ctx, accountID, sessionID); err != nil {
+			return err
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionSessionDeleted, initiator.AccountID, accountID, map[string]any{
+			"session_id": sessionID,
+		})
+	})
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to delete session with id: %s for account %s, cause: %w", sessionID, accountID, err),
+		)
+	}
+
+	return nil
+}
+
+func (s Service) DeleteAccountSessionsByAdmin(ctx context.Context, initiator InitiatorData, accountID uuid.UUID) error {
+	if err := s.validateAdminOf(ctx, initiator, accountID); err != nil {
+		return err
+	}
+
+	err := s.UnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.db.DeleteSessionsForAccount(ctx, accountID); err != nil {
+			return err
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionSessionsDeleted, initiator.AccountID, accountID, nil)
+	})
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to delete sessions for account %s, cause: %w", accountID, err),
+		)
+	}
+
+	return nil
+}
+
+// RevokeSessionsByAdmin revokes the sessions of every account matching the filter, at
+// least one of role and created before must be set. The session of the admin is kept.
+func (s Service) RevokeSessionsByAdmin(
+	ctx context.Context,
+	initiator InitiatorData,
+	filter SessionsFilter,
+	reason string,
+) (int64, error) {
+	if _, err := s.validateAdmin(ctx, initiator); err != nil {
+		return 0, err
+	}
+
+	if filter.Role == "" && filter.CreatedBefore == nil {
+		return 0, errx.ErrorSessionsFilterRequired.Raise(
+			fmt.Errorf("admin %s tried to revoke sessions without a filter", initiator.AccountID),
+		)
+	}
+
+	filter.ExceptSessionID = initiator.SessionID
+
+	var revoked int64
+	err := s.UnitOfWork(ctx, func(ctx context.Context) (err error) {
+		if revoked, err = s.db.DeleteSessions(ctx, filter); err != nil {
+			return err
+		}
+
+		metadata := map[string]any{
+			"revoked": revoked,
+			"reason":  reason,
+		}
+		if filter.Role != "" {
+			metadata["role"] = filter.Role
+		}
+		if filter.CreatedBefore != nil {
+			metadata["created_before"] = filter.CreatedBefore
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionSessionsRevoked, initiator.AccountID, uuid.Nil, metadata)
+	})
+	if err != nil {
+		return 0, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to revoke sessions, cause: %w", err),
+		)
+	}
+
+	return revoked, nil
+}
+
+// validateAdminOf checks the initiator is an admin managing another, existing account.
+func (s Service) validateAdminOf(ctx context.Context, initiator InitiatorData, accountID uuid.UUID) error {
+	if _, err := s.validateAdmin(ctx, initiator); err != nil {
+		return err
+	}
+
+	if accountID == initiator.AccountID {
+		return errx.ErrorCannotManageOwnAccount.Raise(
+			fmt.Errorf("admin %s cannot manage the own account sessions", initiator.AccountID),
+		)
+	}
+
+	_, err := s.GetAccountByID(ctx, accountID)
+
+	return err
+}
@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+const (
+	defaultAuditLogLimit = 20
+	maxAuditLogLimit     = 100
+)
+
+// GetAuditLogByAdmin returns a page of the audit log, newest entries first. The cursor is
+// the NextCursor of the previous page, nil for the first one.
+func (s Service) GetAuditLogByAdmin(
+	ctx context.Context,
+	initiator InitiatorData,
+	filter AuditLogFilter,
+	cursor uuid.UUID,
+	limit uint64,
+) (entity.AuditLogPage, error) {
+	if _, err := s.validateAdmin(ctx, initiator); err != nil {
+		return entity.AuditLogPage{}, err
+	}
+
+	return s.getAuditLog(ctx, filter, cursor, limit)
+}
+
+// GetOwnSecurityActivity returns the audit log entries the initiator made or that were
+// made to the account of the initiator.
+func (s Service) GetOwnSecurityActivity(
+	ctx context.Context,
+	initiator InitiatorData,
+	cursor uuid.UUID,
+	limit uint64,
+) (entity.AuditLogPage, error) {
+	if _, _, err := s.ValidateSession(ctx, initiator); err != nil {
+		return entity.AuditLogPage{}, err
+	}
+
+	return s.getAuditLog(ctx, AuditLogFilter{AccountID: initiator.AccountID}, cursor, limit)
+}
+
+func (s Service) getAuditLog(
+	ctx context.Context,
+	filter AuditLogFilter,
+	cursor uuid.UUID,
+	limit uint64,
+) (entity.AuditLogPage, error) {
+	switch {
+	case limit == 0:
+		limit = defaultAuditLogLimit
+	case limit > maxAuditLogLimit:
+		limit = maxAuditLogLimit
+	}
+
+	// one entry more than the page tells whether there is a next page
+	entries, err := s.db.GetAuditLog(ctx, filter, cursor, limit+1)
+	if err != nil {
+		return entity.AuditLogPage{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to select audit log, cause: %w", err),
+		)
+	}
+
+	page := entity.AuditLogPage{Data: entries, Limit: limit}
+	if uint64(len(entries)) > limit {
+		page.Data = entries[:limit]
+		page.NextCursor = page.Data[limit-1].ID
+	}
+
+	return page, nil
+}
+
+// writeAudit records a change in the audit log with the client of the request. It is
+// called in the transaction of the change, so a change is never made without its entry.
+func (s Service) writeAudit(
+	ctx context.Context,
+	action string,
+	actorID, targetID uuid.UUID,
+	metadata map[string]any,
+) error {
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to encode %s audit metadata, cause: %w", action, err),
+		)
+	}
+
+	client := ClientDataFromCtx(ctx)
+
+	err = s.db.CreateAuditLogEntry(ctx, entity.AuditLogEntry{
+		ID:        uuid.New(),
+		ActorID:   actorID,
+		TargetID:  targetID,
+		Action:    action,
+		IP:        client.IP,
+		UserAgent: client.UserAgent,
+		Metadata:  raw,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to write %s audit log entry for account %s, cause: %w", action, targetID, err),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/useragent"
+)
+
+type clientDataCtxKey struct{}
+
+// ClientData describes the client a request came from, it is recorded with the changes
+// the request makes and with the sessions it opens. ClientName is the name the client
+// gives itself, GeoHint the location the proxy in front of the service resolved.
+type ClientData struct {
+	IP         string
+	UserAgent  string
+	ClientName string
+	GeoHint    string
+}
+
+func WithClientData(ctx context.Context, client ClientData) context.Context {
+	return context.WithValue(ctx, clientDataCtxKey{}, client)
+}
+
+// ClientDataFromCtx returns the client put into the context by WithClientData, a zero
+// client for changes not made through a request.
+func ClientDataFromCtx(ctx context.Context) ClientData {
+	client, _ := ctx.Value(clientDataCtxKey{}).(ClientData)
+	return client
+}
+
+// sessionClient describes the client of the request for a session it opens.
+func sessionClient(ctx context.Context) entity.SessionClient {
+	client := ClientDataFromCtx(ctx)
+	info := useragent.Parse(client.UserAgent)
+
+	return entity.SessionClient{
+		IP:         client.IP,
+		UserAgent:  client.UserAgent,
+		Device:     info.Device,
+		OS:         info.OS,
+		Browser:    info.Browser,
+		ClientName: client.ClientName,
+		GeoHint:    client.GeoHint,
+	}
+}
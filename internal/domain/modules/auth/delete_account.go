@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/umisto/sso-svc/internal/domain/entity"
 	"github.com/umisto/sso-svc/internal/domain/errx"
 )
 
@@ -13,7 +14,13 @@ func (s Service) DeleteOwnAccount(ctx context.Context, initiator InitiatorData)
 		return err
 	}
 
-	err = s.db.DeleteAccount(ctx, initiator.AccountID)
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		if err = s.db.DeleteAccount(ctx, initiator.AccountID); err != nil {
+			return err
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionAccountDeleted, initiator.AccountID, initiator.AccountID, nil)
+	})
 	if err != nil {
 		return errx.ErrorInternal.Raise(
 			fmt.Errorf("failed to delete account with id: %s, cause: %w", initiator.AccountID, err),
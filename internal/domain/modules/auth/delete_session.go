@@ -5,11 +5,20 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
 	"github.com/umisto/sso-svc/internal/domain/errx"
 )
 
 func (s Service) Logout(ctx context.Context, initiator InitiatorData) error {
-	err := s.db.DeleteAccountSession(ctx, initiator.AccountID, initiator.SessionID)
+	err := s.UnitOfWork(ctx, func(ctx context.Context) error {
+		if err := s.db.DeleteAccountSession(ctx, initiator.AccountID, initiator.SessionID); err != nil {
+			return err
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionSessionLogout, initiator.AccountID, initiator.AccountID, map[string]any{
+			"session_id": initiator.SessionID,
+		})
+	})
 	if err != nil {
 		return errx.ErrorInternal.Raise(
 			fmt.Errorf("failed to delete session with id: %s, cause: %w", initiator.SessionID, err),
@@ -25,7 +34,15 @@ func (s Service) DeleteOwnSession(ctx context.Context, initiator InitiatorData,
 		return err
 	}
 
-	err = s.db.DeleteAccountSession(ctx, initiator.AccountID, sessionID)
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		if err = s.db.DeleteAccountSession(ctx, initiator.AccountID, sessionID); err != nil {
+			return err
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionSessionDeleted, initiator.AccountID, initiator.AccountID, map[string]any{
+			"session_id": sessionID,
+		})
+	})
 	if err != nil {
 		return errx.ErrorInternal.Raise(
 			fmt.Errorf("failed to delete session with id: %s for account %s, cause: %w", sessionID, initiator.AccountID, err),
@@ -41,7 +58,13 @@ func (s Service) DeleteOwnSessions(ctx context.Context, initiator InitiatorData)
 		return err
 	}
 
-	err = s.db.DeleteSessionsForAccount(ctx, initiator.AccountID)
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		if err = s.db.DeleteSessionsForAccount(ctx, initiator.AccountID); err != nil {
+			return err
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionSessionsDeleted, initiator.AccountID, initiator.AccountID, nil)
+	})
 	if err != nil {
 		return errx.ErrorInternal.Raise(
 			fmt.Errorf("failed to delete sessions for account %s, cause: %w", initiator.AccountID, err),
@@ -42,5 +42,11 @@ func (s Service) ValidateSession(
 		)
 	}
 
+	if err = session.CanBeUsed(s.cfg.Sessions.Expiry); err != nil {
+		return entity.Account{}, entity.Session{}, errx.ErrorInitiatorInvalidSession.Raise(
+			fmt.Errorf("session with id '%s' cannot be used, cause: %w", initiator.SessionID, err),
+		)
+	}
+
 	return account, session, nil
 }
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+// checkLoginThrottle fails with ErrorAccountTemporarilyLocked while the account or
+// client ip is locked out after too many failed password or mfa attempts.
+func (s Service) checkLoginThrottle(ctx context.Context, kind, subject string) error {
+	if subject == "" || !s.lockoutPolicy(kind).IsEnabled() {
+		return nil
+	}
+
+	throttle, err := s.db.GetLoginThrottle(ctx, kind, subject)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get login throttle for %s %s, cause: %w", kind, subject, err),
+		)
+	}
+
+	return throttle.CanAttemptLogin(time.Now().UTC())
+}
+
+func (s Service) registerLoginFailure(ctx context.Context, kind, subject string) (entity.LoginThrottle, error) {
+	policy := s.lockoutPolicy(kind)
+	if subject == "" || !policy.IsEnabled() {
+		return entity.LoginThrottle{}, nil
+	}
+
+	throttle, err := s.db.RegisterLoginFailure(ctx, kind, subject, policy)
+	if err != nil {
+		return entity.LoginThrottle{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to register login failure for %s %s, cause: %w", kind, subject, err),
+		)
+	}
+
+	return throttle, nil
+}
+
+// failLogin counts a wrong password or mfa code against both the account and the client
+// ip and reports it, the original error is returned to the caller.
+func (s Service) failLogin(ctx context.Context, account entity.Account, ip string, cause error) error {
+	email, err := s.GetAccountEmail(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		throttle, err := s.registerLoginFailure(ctx, entity.LoginThrottleAccount, account.ID.String())
+		if err != nil {
+			return err
+		}
+
+		if _, err = s.registerLoginFailure(ctx, entity.LoginThrottleIP, ip); err != nil {
+			return err
+		}
+
+		err = s.event.WriteAccountLoginFailed(ctx, account, email.Email, ip, throttle.FailedAttempts, throttle.LockedUntil)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to publish account login failed event for account %s: %w", account.ID, err),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return cause
+}
+
+func (s Service) lockoutPolicy(kind string) entity.LoginLockoutPolicy {
+	switch kind {
+	case entity.LoginThrottleAccount:
+		return s.cfg.Lockout.Account
+	case entity.LoginThrottleIP:
+		return s.cfg.Lockout.IP
+	default:
+		return entity.LoginLockoutPolicy{}
+	}
+}
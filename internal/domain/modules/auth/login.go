@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -9,53 +10,145 @@ import (
 	"github.com/umisto/sso-svc/internal/domain/errx"
 )
 
-func (s Service) LoginByEmail(ctx context.Context, email, password string) (entity.TokensPair, error) {
+// LoginByEmail opens a session after a password check. When the account has mfa
+// enabled no session is created; a challenge is returned instead, see LoginByMFA.
+// ip is the client address used for the per-ip lockout, it may be empty.
+func (s Service) LoginByEmail(
+	ctx context.Context,
+	email, password, ip string,
+) (entity.TokensPair, entity.MFAChallenge, error) {
+	err := s.checkLoginThrottle(ctx, entity.LoginThrottleIP, ip)
+	if err != nil {
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
+	}
+
 	account, err := s.GetAccountByEmail(ctx, email)
 	if err != nil {
-		return entity.TokensPair{}, err
+		if errors.Is(err, errx.ErrorAccountNotFound) {
+			if _, ferr := s.registerLoginFailure(ctx, entity.LoginThrottleIP, ip); ferr != nil {
+				return entity.TokensPair{}, entity.MFAChallenge{}, ferr
+			}
+		}
+
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
 	}
 
 	if err = account.CanInteract(); err != nil {
-		return entity.TokensPair{}, err
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
 	}
 
-	err = s.checkAccountPassword(ctx, account.ID, password)
+	return s.loginWithPassword(ctx, account, password, ip)
+}
+
+// LoginByUsername opens a session after a password check. When the account has mfa
+// enabled no session is created; a challenge is returned instead, see LoginByMFA.
+// ip is the client address used for the per-ip lockout, it may be empty.
+func (s Service) LoginByUsername(
+	ctx context.Context,
+	username, password, ip string,
+) (entity.TokensPair, entity.MFAChallenge, error) {
+	err := s.checkLoginThrottle(ctx, entity.LoginThrottleIP, ip)
 	if err != nil {
-		return entity.TokensPair{}, err
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
 	}
 
-	return s.createSession(ctx, account)
+	account, err := s.GetAccountByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, errx.ErrorAccountNotFound) {
+			if _, ferr := s.registerLoginFailure(ctx, entity.LoginThrottleIP, ip); ferr != nil {
+				return entity.TokensPair{}, entity.MFAChallenge{}, ferr
+			}
+		}
+
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
+	}
+
+	if err = account.CanInteract(); err != nil {
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
+	}
+
+	return s.loginWithPassword(ctx, account, password, ip)
 }
 
-func (s Service) LoginByUsername(ctx context.Context, username, password string) (entity.TokensPair, error) {
-	account, err := s.GetAccountByUsername(ctx, username)
+// LoginBySocialIdentity logs in the account linked to the provider subject. An unknown
+// subject is linked to the account with the same email, but only when the provider
+// asserts it verified that email, otherwise anyone could claim the address. When the
+// account has mfa enabled no session is created; a challenge is returned instead.
+func (s Service) LoginBySocialIdentity(
+	ctx context.Context,
+	identity entity.SocialIdentity,
+) (entity.TokensPair, entity.MFAChallenge, error) {
+	account, err := s.getLinkedAccount(ctx, identity)
 	if err != nil {
-		return entity.TokensPair{}, err
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
+	}
+
+	if account.IsNil() {
+		account, err = s.linkSocialIdentity(ctx, identity)
+		if err != nil {
+			return entity.TokensPair{}, entity.MFAChallenge{}, err
+		}
 	}
 
 	if err = account.CanInteract(); err != nil {
-		return entity.TokensPair{}, err
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
 	}
 
-	err = s.checkAccountPassword(ctx, account.ID, password)
+	challenge, err := s.startMFAChallenge(ctx, account)
 	if err != nil {
-		return entity.TokensPair{}, err
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
+	}
+	if !challenge.IsNil() {
+		return entity.TokensPair{}, challenge, nil
+	}
+
+	pair, err := s.createSession(ctx, account)
+	if err != nil {
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
 	}
 
-	return s.createSession(ctx, account)
+	return pair, entity.MFAChallenge{}, nil
 }
 
-func (s Service) LoginByGoogle(ctx context.Context, email string) (entity.TokensPair, error) {
-	account, err := s.GetAccountByEmail(ctx, email)
+func (s Service) loginWithPassword(
+	ctx context.Context,
+	account entity.Account,
+	password, ip string,
+) (entity.TokensPair, entity.MFAChallenge, error) {
+	err := s.checkLoginThrottle(ctx, entity.LoginThrottleAccount, account.ID.String())
 	if err != nil {
-		return entity.TokensPair{}, err
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
 	}
 
-	if err = account.CanInteract(); err != nil {
-		return entity.TokensPair{}, err
+	err = s.checkAccountPassword(ctx, account.ID, password)
+	if errors.Is(err, errx.ErrorPasswordInvalid) {
+		return entity.TokensPair{}, entity.MFAChallenge{}, s.failLogin(ctx, account, ip, err)
+	}
+	if err != nil {
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
+	}
+
+	err = s.db.ResetLoginThrottle(ctx, entity.LoginThrottleAccount, account.ID.String())
+	if err != nil {
+		return entity.TokensPair{}, entity.MFAChallenge{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to reset login throttle for account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	challenge, err := s.startMFAChallenge(ctx, account)
+	if err != nil {
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
+	}
+	if !challenge.IsNil() {
+		return entity.TokensPair{}, challenge, nil
+	}
+
+	pair, err := s.createSession(ctx, account)
+	if err != nil {
+		return entity.TokensPair{}, entity.MFAChallenge{}, err
 	}
 
-	return s.createSession(ctx, account)
+	return pair, entity.MFAChallenge{}, nil
 }
 
 func (s Service) checkAccountPassword(
@@ -80,38 +173,61 @@ func (s Service) checkAccountPassword(
 func (s Service) createSession(
 	ctx context.Context,
 	account entity.Account,
+) (entity.TokensPair, error) {
+	return s.openSession(ctx, account, OAuthGrant{})
+}
+
+// openSession opens a session for the account and issues its tokens. A session opened for
+// an oauth client is bound to it and its tokens carry the granted scopes.
+func (s Service) openSession(
+	ctx context.Context,
+	account entity.Account,
+	grant OAuthGrant,
 ) (entity.TokensPair, error) {
 	sessionID := uuid.New()
 
-	pair, err := s.createTokensPair(sessionID, account)
+	pair, err := s.createTokensPair(sessionID, account, grant)
 	if err != nil {
 		return entity.TokensPair{}, err
 	}
 
-	refreshTokenCrypto, err := s.jwt.EncryptRefresh(pair.Refresh)
+	refreshTokenCrypto, err := s.encryptRefresh(account, pair.Refresh)
 	if err != nil {
-		return entity.TokensPair{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("failed to encrypt refresh token for account %s, cause: %w", account.ID, err),
-		)
+		return entity.TokensPair{}, err
 	}
 
-	_, err = s.db.CreateSession(ctx, sessionID, account.ID, refreshTokenCrypto)
-	if err != nil {
-		return entity.TokensPair{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("failed to createSession session for account %s, cause: %w", account.ID, err),
-		)
-	}
+	client := sessionClient(ctx)
+	client.OAuthClientID = grant.ClientID
 
 	email, err := s.GetAccountEmail(ctx, account.ID)
 	if err != nil {
 		return entity.TokensPair{}, err
 	}
 
-	err = s.event.WriteAccountLogin(ctx, account, email.Email)
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		evicted, err := s.evictSessionsOverLimit(ctx, account)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.db.CreateSession(ctx, sessionID, account.ID, refreshTokenCrypto, client)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to createSession session for account %s, cause: %w", account.ID, err),
+			)
+		}
+
+		err = s.event.WriteAccountLogin(ctx, account, email.Email, sessionID, evicted)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to publish account login event for account %s: %w", account.ID, err),
+			)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return entity.TokensPair{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("failed to publish account login event for account %s: %w", account.ID, err),
-		)
+		return entity.TokensPair{}, err
 	}
 
 	return entity.TokensPair{
@@ -121,18 +237,71 @@ func (s Service) createSession(
 	}, nil
 }
 
+// evictSessionsOverLimit makes room for one more session of the account under the session
+// limit, ending the least recently used sessions or refusing the login as configured. It
+// returns the ids of the ended sessions. Call it within the transaction creating the session.
+func (s Service) evictSessionsOverLimit(ctx context.Context, account entity.Account) ([]uuid.UUID, error) {
+	sessions, err := s.db.LockAccountSessions(ctx, account.ID)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to lock sessions of account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	evict, err := s.cfg.Sessions.Limit.Admit(account, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(evict))
+	for _, session := range evict {
+		if err = s.db.DeleteSession(ctx, session.ID); err != nil {
+			return nil, errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to evict session %s of account %s, cause: %w", session.ID, account.ID, err),
+			)
+		}
+
+		err = s.writeAudit(ctx, entity.AuditActionSessionEvicted, account.ID, account.ID, map[string]any{
+			"session_id": session.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, session.ID)
+	}
+
+	return ids, nil
+}
+
+// createTokensPair issues the tokens of a session. The tokens of an oauth client are
+// addressed to it and carry the granted scopes, a refresh token is left out unless the
+// grant allows offline access.
 func (s Service) createTokensPair(
 	sessionID uuid.UUID,
 	account entity.Account,
+	grant OAuthGrant,
 ) (entity.TokensPair, error) {
-	access, err := s.jwt.GenerateAccess(account, sessionID)
+	var access, refresh string
+	var err error
+
+	if grant.IsFirstParty() {
+		access, err = s.jwt.GenerateAccess(account, sessionID)
+	} else {
+		access, err = s.jwt.GenerateClientAccess(account, sessionID, grant.ClientID, grant.Scopes)
+	}
 	if err != nil {
 		return entity.TokensPair{}, errx.ErrorInternal.Raise(
 			fmt.Errorf("failed to generate access token for account %s, cause: %w", account.ID, err),
 		)
 	}
 
-	refresh, err := s.jwt.GenerateRefresh(account, sessionID)
+	switch {
+	case grant.IsFirstParty():
+		refresh, err = s.jwt.GenerateRefresh(account, sessionID)
+	case grant.issuesRefresh():
+		refresh, err = s.jwt.GenerateClientRefresh(account, sessionID, grant.ClientID, grant.Scopes)
+	}
 	if err != nil {
 		return entity.TokensPair{}, errx.ErrorInternal.Raise(
 			fmt.Errorf("failed to generate refresh token for account %s, cause: %w", account.ID, err),
@@ -145,3 +314,20 @@ func (s Service) createTokensPair(
 		Access:    access,
 	}, nil
 }
+
+// encryptRefresh encrypts the refresh token to be stored with the session. A session
+// without a refresh token stores an empty one, it can never be refreshed.
+func (s Service) encryptRefresh(account entity.Account, refresh string) (string, error) {
+	if refresh == "" {
+		return "", nil
+	}
+
+	refreshCrypto, err := s.jwt.EncryptRefresh(refresh)
+	if err != nil {
+		return "", errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to encrypt refresh token for account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	return refreshCrypto, nil
+}
@@ -0,0 +1,355 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+func (s Service) SetupTOTP(ctx context.Context, initiator InitiatorData, password string) (entity.TOTPSetup, error) {
+	_, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return entity.TOTPSetup{}, err
+	}
+
+	mfa, err := s.getAccountMFA(ctx, initiator)
+	if err != nil {
+		return entity.TOTPSetup{}, err
+	}
+	if mfa.IsEnabled() {
+		return entity.TOTPSetup{}, errx.ErrorMFAAlreadyEnabled.Raise(
+			fmt.Errorf("account %s already has mfa enabled", initiator.AccountID),
+		)
+	}
+
+	if err = s.checkAccountPassword(ctx, initiator.AccountID, password); err != nil {
+		return entity.TOTPSetup{}, err
+	}
+
+	email, err := s.GetAccountEmail(ctx, initiator.AccountID)
+	if err != nil {
+		return entity.TOTPSetup{}, err
+	}
+
+	secret, err := s.jwt.GenerateTOTPSecret()
+	if err != nil {
+		return entity.TOTPSetup{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to generate totp secret for account %s, cause: %w", initiator.AccountID, err),
+		)
+	}
+
+	encryptedSecret, err := s.jwt.EncryptTOTPSecret(secret)
+	if err != nil {
+		return entity.TOTPSetup{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to encrypt totp secret for account %s, cause: %w", initiator.AccountID, err),
+		)
+	}
+
+	_, err = s.db.CreateAccountMFA(ctx, initiator.AccountID, encryptedSecret)
+	if err != nil {
+		return entity.TOTPSetup{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to save totp secret for account %s, cause: %w", initiator.AccountID, err),
+		)
+	}
+
+	return entity.TOTPSetup{
+		Secret: secret,
+		URI:    s.jwt.TOTPURI(secret, email.Email),
+	}, nil
+}
+
+// ConfirmTOTP enables mfa once the user proves the authenticator app is set up and
+// returns the recovery codes, which are shown only this one time.
+func (s Service) ConfirmTOTP(ctx context.Context, initiator InitiatorData, code string) ([]string, error) {
+	_, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return nil, err
+	}
+
+	mfa, err := s.getAccountMFA(ctx, initiator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = mfa.CanBeEnabled(); err != nil {
+		return nil, err
+	}
+
+	step, err := s.validateTOTPCode(mfa, code)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to generate recovery codes for account %s, cause: %w", initiator.AccountID, err),
+		)
+	}
+
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		_, err = s.db.EnableAccountMFA(ctx, initiator.AccountID, step, hashes)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to enable mfa for account %s, cause: %w", initiator.AccountID, err),
+			)
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionTOTPEnabled, initiator.AccountID, initiator.AccountID, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
+
+func (s Service) DisableTOTP(ctx context.Context, initiator InitiatorData, password, code string) error {
+	_, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return err
+	}
+
+	mfa, err := s.getAccountMFA(ctx, initiator)
+	if err != nil {
+		return err
+	}
+	if !mfa.IsEnabled() {
+		return errx.ErrorMFANotEnabled.Raise(
+			fmt.Errorf("account %s has no mfa enabled", initiator.AccountID),
+		)
+	}
+
+	if err = s.checkAccountPassword(ctx, initiator.AccountID, password); err != nil {
+		return err
+	}
+
+	if err = s.checkMFACode(ctx, mfa, code); err != nil {
+		return err
+	}
+
+	return s.UnitOfWork(ctx, func(ctx context.Context) error {
+		err = s.db.DeleteAccountMFA(ctx, initiator.AccountID)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to disable mfa for account %s, cause: %w", initiator.AccountID, err),
+			)
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionTOTPDisabled, initiator.AccountID, initiator.AccountID, nil)
+	})
+}
+
+// LoginByMFA exchanges the challenge issued by a password login together with a TOTP
+// or recovery code for a new session. Every challenge takes a limited number of codes
+// and opens one session, a wrong code counts against the account and ip lockouts.
+// ip is the client address used for the per-ip lockout, it may be empty.
+func (s Service) LoginByMFA(ctx context.Context, challenge, code, ip string) (entity.TokensPair, error) {
+	challengeID, accountID, err := s.jwt.ParseMFAChallenge(challenge)
+	if err != nil {
+		return entity.TokensPair{}, errx.ErrorMFAChallengeInvalid.Raise(
+			fmt.Errorf("failed to parse mfa challenge, cause: %w", err),
+		)
+	}
+
+	if err = s.checkLoginThrottle(ctx, entity.LoginThrottleIP, ip); err != nil {
+		return entity.TokensPair{}, err
+	}
+	if err = s.checkLoginThrottle(ctx, entity.LoginThrottleAccount, accountID.String()); err != nil {
+		return entity.TokensPair{}, err
+	}
+
+	stored, err := s.db.CountMFAChallengeAttempt(ctx, challengeID, entity.MFAChallengeMaxAttempts)
+	if err != nil {
+		return entity.TokensPair{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to count attempt of mfa challenge %s, cause: %w", challengeID, err),
+		)
+	}
+	if err = stored.CanBeAnswered(accountID); err != nil {
+		return entity.TokensPair{}, err
+	}
+
+	account, err := s.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return entity.TokensPair{}, err
+	}
+
+	if err = account.CanInteract(); err != nil {
+		return entity.TokensPair{}, err
+	}
+
+	mfa, err := s.db.GetAccountMFA(ctx, accountID)
+	if err != nil {
+		return entity.TokensPair{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get mfa for account %s, cause: %w", accountID, err),
+		)
+	}
+	if !mfa.IsEnabled() {
+		return entity.TokensPair{}, errx.ErrorMFAChallengeInvalid.Raise(
+			fmt.Errorf("account %s has no mfa enabled", accountID),
+		)
+	}
+
+	err = s.checkMFACode(ctx, mfa, code)
+	if errors.Is(err, errx.ErrorMFACodeInvalid) {
+		return entity.TokensPair{}, s.failLogin(ctx, account, ip, err)
+	}
+	if err != nil {
+		return entity.TokensPair{}, err
+	}
+
+	var pair entity.TokensPair
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		consumed, err := s.db.ConsumeMFAChallenge(ctx, challengeID)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to consume mfa challenge %s, cause: %w", challengeID, err),
+			)
+		}
+		if consumed.IsNil() {
+			return errx.ErrorMFAChallengeInvalid.Raise(
+				fmt.Errorf("mfa challenge %s has already been used", challengeID),
+			)
+		}
+
+		pair, err = s.createSession(ctx, account)
+		return err
+	})
+	if err != nil {
+		return entity.TokensPair{}, err
+	}
+
+	return pair, nil
+}
+
+// startMFAChallenge returns a challenge when the account has mfa enabled, and a nil
+// challenge when the first factor alone is enough to open a session.
+func (s Service) startMFAChallenge(ctx context.Context, account entity.Account) (entity.MFAChallenge, error) {
+	mfa, err := s.db.GetAccountMFA(ctx, account.ID)
+	if err != nil {
+		return entity.MFAChallenge{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get mfa for account %s, cause: %w", account.ID, err),
+		)
+	}
+	if !mfa.IsEnabled() {
+		return entity.MFAChallenge{}, nil
+	}
+
+	challengeID := uuid.New()
+
+	token, expiresAt, err := s.jwt.GenerateMFAChallenge(challengeID, account.ID)
+	if err != nil {
+		return entity.MFAChallenge{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to generate mfa challenge for account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	challenge, err := s.db.CreateMFAChallenge(ctx, challengeID, account.ID, expiresAt)
+	if err != nil {
+		return entity.MFAChallenge{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to save mfa challenge for account %s, cause: %w", account.ID, err),
+		)
+	}
+	challenge.Token = token
+
+	return challenge, nil
+}
+
+func (s Service) getAccountMFA(ctx context.Context, initiator InitiatorData) (entity.AccountMFA, error) {
+	mfa, err := s.db.GetAccountMFA(ctx, initiator.AccountID)
+	if err != nil {
+		return entity.AccountMFA{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get mfa for account %s, cause: %w", initiator.AccountID, err),
+		)
+	}
+
+	return mfa, nil
+}
+
+// checkMFACode accepts either a current TOTP code or an unused recovery code and
+// consumes it, so neither can be replayed.
+func (s Service) checkMFACode(ctx context.Context, mfa entity.AccountMFA, code string) error {
+	step, err := s.validateTOTPCode(mfa, code)
+	switch {
+	case errors.Is(err, errx.ErrorMFACodeInvalid):
+		// not a current totp code, fall back to recovery codes
+	case err != nil:
+		return err
+	default:
+		ok, err := s.db.UseAccountMFATOTPStep(ctx, mfa.AccountID, step)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to save totp step for account %s, cause: %w", mfa.AccountID, err),
+			)
+		}
+		if !ok {
+			return errx.ErrorMFACodeInvalid.Raise(
+				fmt.Errorf("totp code for account %s has already been used", mfa.AccountID),
+			)
+		}
+
+		return nil
+	}
+
+	ok, err := s.db.UseAccountMFARecoveryCode(ctx, mfa.AccountID, hashSecretToken(normalizeRecoveryCode(code)))
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to use recovery code for account %s, cause: %w", mfa.AccountID, err),
+		)
+	}
+	if !ok {
+		return errx.ErrorMFACodeInvalid.Raise(
+			fmt.Errorf("invalid mfa code for account %s", mfa.AccountID),
+		)
+	}
+
+	return nil
+}
+
+func (s Service) validateTOTPCode(mfa entity.AccountMFA, code string) (int64, error) {
+	secret, err := s.jwt.DecryptTOTPSecret(mfa.TOTPSecret)
+	if err != nil {
+		return 0, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to decrypt totp secret for account %s, cause: %w", mfa.AccountID, err),
+		)
+	}
+
+	step, ok := s.jwt.ValidateTOTP(secret, code, time.Now().UTC())
+	if !ok {
+		return 0, errx.ErrorMFACodeInvalid.Raise(
+			fmt.Errorf("invalid totp code for account %s", mfa.AccountID),
+		)
+	}
+
+	return step, nil
+}
+
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, entity.MFARecoveryCodesCount)
+	hashes := make([]string, 0, entity.MFARecoveryCodesCount)
+
+	for i := 0; i < entity.MFARecoveryCodesCount; i++ {
+		buf := make([]byte, 5)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+
+		raw := hex.EncodeToString(buf)
+		codes = append(codes, raw[:5]+"-"+raw[5:])
+		hashes = append(hashes, hashSecretToken(raw))
+	}
+
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
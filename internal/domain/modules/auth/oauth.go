@@ -0,0 +1,350 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/token"
+)
+
+type NewOAuthClientParams struct {
+	Name         string
+	RedirectURIs []string
+	Scopes       []string
+	Public       bool
+	SkipConsent  bool
+}
+
+// NewOAuthClient builds a client ready to be stored. The secret of a confidential client
+// is returned once, only its hash is kept.
+func NewOAuthClient(params NewOAuthClientParams) (entity.OAuthClient, string, error) {
+	if len(params.RedirectURIs) == 0 {
+		return entity.OAuthClient{}, "", fmt.Errorf("at least one redirect uri is required")
+	}
+
+	for _, scope := range params.Scopes {
+		if !slices.Contains(entity.OAuthScopes, scope) {
+			return entity.OAuthClient{}, "", fmt.Errorf("unsupported scope %q", scope)
+		}
+	}
+
+	client := entity.OAuthClient{
+		ID:           uuid.NewString(),
+		Name:         params.Name,
+		RedirectURIs: params.RedirectURIs,
+		Scopes:       params.Scopes,
+		SkipConsent:  params.SkipConsent,
+	}
+
+	if params.Public {
+		return client, "", nil
+	}
+
+	secret, err := generateSecretToken()
+	if err != nil {
+		return entity.OAuthClient{}, "", fmt.Errorf("generate client secret: %w", err)
+	}
+
+	client.SecretHash = hashSecretToken(secret)
+
+	return client, secret, nil
+}
+
+type AuthorizeParams struct {
+	ClientID            string
+	RedirectURI         string
+	Scopes              []string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	// GrantConsent is set once the user has approved the scopes on the consent screen.
+	GrantConsent bool
+}
+
+// ValidateAuthorizeRequest checks an authorization request before the user is asked to
+// log in. Only the S256 pkce method is accepted, for public and confidential clients alike.
+func (s Service) ValidateAuthorizeRequest(ctx context.Context, params AuthorizeParams) (entity.OAuthClient, error) {
+	client, err := s.GetOAuthClient(ctx, params.ClientID)
+	if err != nil {
+		return entity.OAuthClient{}, err
+	}
+
+	if err = client.CanRedirectTo(params.RedirectURI); err != nil {
+		return entity.OAuthClient{}, err
+	}
+
+	if err = client.CanRequestScopes(params.Scopes); err != nil {
+		return entity.OAuthClient{}, err
+	}
+
+	if params.CodeChallenge == "" || params.CodeChallengeMethod != entity.PKCEMethodS256 {
+		return entity.OAuthClient{}, errx.ErrorOAuthRequestInvalid.Raise(
+			fmt.Errorf("pkce code challenge with method %s is required", entity.PKCEMethodS256),
+		)
+	}
+
+	return client, nil
+}
+
+// Authorize issues an authorization code for the logged in user. Clients that are not
+// trusted to skip consent need the user to have approved every requested scope.
+func (s Service) Authorize(ctx context.Context, initiator InitiatorData, params AuthorizeParams) (string, error) {
+	account, session, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := s.ValidateAuthorizeRequest(ctx, params)
+	if err != nil {
+		return "", err
+	}
+
+	if !client.SkipConsent {
+		if err = s.checkOAuthConsent(ctx, account.ID, client, params); err != nil {
+			return "", err
+		}
+	}
+
+	code, err := generateSecretToken()
+	if err != nil {
+		return "", errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to generate authorization code for account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	_, err = s.db.CreateOAuthAuthorizationCode(ctx, entity.OAuthAuthorizationCode{
+		CodeHash:            hashSecretToken(code),
+		ClientID:            client.ID,
+		AccountID:           account.ID,
+		RedirectURI:         params.RedirectURI,
+		Scopes:              params.Scopes,
+		Nonce:               params.Nonce,
+		CodeChallenge:       params.CodeChallenge,
+		CodeChallengeMethod: params.CodeChallengeMethod,
+		AuthTime:            session.CreatedAt,
+		ExpiresAt:           time.Now().UTC().Add(s.cfg.OAuth.AuthorizationCodeTTL),
+	})
+	if err != nil {
+		return "", errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to save authorization code for account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	return code, nil
+}
+
+type ExchangeAuthorizationCodeParams struct {
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+}
+
+// ExchangeAuthorizationCode redeems a code at the token endpoint. A new session bound to
+// the client is opened for the account, its access token is addressed to the client and
+// carries the granted scopes. A refresh token is issued only for offline access. The id
+// token is addressed to the client as well.
+func (s Service) ExchangeAuthorizationCode(
+	ctx context.Context,
+	params ExchangeAuthorizationCodeParams,
+) (entity.OAuthTokens, error) {
+	client, err := s.authenticateOAuthClient(ctx, params.ClientID, params.ClientSecret)
+	if err != nil {
+		return entity.OAuthTokens{}, err
+	}
+
+	code, err := s.db.ConsumeOAuthAuthorizationCode(ctx, hashSecretToken(params.Code))
+	if err != nil {
+		return entity.OAuthTokens{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get authorization code for oauth client %s, cause: %w", client.ID, err),
+		)
+	}
+
+	if err = code.CanBeExchanged(client.ID, params.RedirectURI, params.CodeVerifier); err != nil {
+		return entity.OAuthTokens{}, err
+	}
+
+	account, err := s.GetAccountByID(ctx, code.AccountID)
+	if err != nil {
+		return entity.OAuthTokens{}, err
+	}
+
+	if err = account.CanInteract(); err != nil {
+		return entity.OAuthTokens{}, err
+	}
+
+	email, err := s.GetAccountEmail(ctx, account.ID)
+	if err != nil {
+		return entity.OAuthTokens{}, err
+	}
+
+	pair, err := s.openSession(ctx, account, OAuthGrant{
+		ClientID: client.ID,
+		Scopes:   code.Scopes,
+	})
+	if err != nil {
+		return entity.OAuthTokens{}, err
+	}
+
+	idToken, err := s.jwt.GenerateIDToken(account, email, token.IDTokenParams{
+		ClientID: client.ID,
+		Nonce:    code.Nonce,
+		AuthTime: code.AuthTime,
+		Scopes:   code.Scopes,
+	})
+	if err != nil {
+		return entity.OAuthTokens{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to generate id token for account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	return entity.OAuthTokens{
+		TokensPair: pair,
+		IDToken:    idToken,
+		Scopes:     code.Scopes,
+	}, nil
+}
+
+// RefreshOAuthTokens rotates the session tokens for a client. Only a refresh token issued
+// to the client, of a session opened for it, is accepted.
+func (s Service) RefreshOAuthTokens(
+	ctx context.Context,
+	clientID, clientSecret string,
+	refreshToken string,
+) (entity.OAuthTokens, error) {
+	client, err := s.authenticateOAuthClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return entity.OAuthTokens{}, err
+	}
+
+	pair, grant, err := s.refreshSession(ctx, refreshToken, client.ID)
+	if err != nil {
+		return entity.OAuthTokens{}, err
+	}
+
+	return entity.OAuthTokens{
+		TokensPair: pair,
+		Scopes:     grant.Scopes,
+	}, nil
+}
+
+// GetUserInfo returns the claims about the user the grant allows the client to read,
+// the username with the profile scope and the email with the email scope.
+func (s Service) GetUserInfo(ctx context.Context, initiator InitiatorData, grant OAuthGrant) (entity.UserInfo, error) {
+	account, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return entity.UserInfo{}, err
+	}
+
+	info := entity.UserInfo{
+		Subject: account.ID,
+	}
+
+	if grant.Allows(entity.OAuthScopeProfile) {
+		info.Username = account.Username
+	}
+
+	if grant.Allows(entity.OAuthScopeEmail) {
+		email, err := s.GetAccountEmail(ctx, account.ID)
+		if err != nil {
+			return entity.UserInfo{}, err
+		}
+
+		info.Email = email.Email
+		info.EmailVerified = &email.Verified
+	}
+
+	return info, nil
+}
+
+func (s Service) GetOAuthClient(ctx context.Context, clientID string) (entity.OAuthClient, error) {
+	client, err := s.db.GetOAuthClient(ctx, clientID)
+	if err != nil {
+		return entity.OAuthClient{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get oauth client %s, cause: %w", clientID, err),
+		)
+	}
+	if client.IsNil() {
+		return entity.OAuthClient{}, errx.ErrorOAuthClientNotFound.Raise(
+			fmt.Errorf("oauth client %s not found", clientID),
+		)
+	}
+
+	return client, nil
+}
+
+func (s Service) authenticateOAuthClient(ctx context.Context, clientID, clientSecret string) (entity.OAuthClient, error) {
+	client, err := s.GetOAuthClient(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, errx.ErrorOAuthClientNotFound) {
+			return entity.OAuthClient{}, errx.ErrorOAuthClientUnauthorized.Raise(err)
+		}
+		return entity.OAuthClient{}, err
+	}
+
+	if client.IsPublic() {
+		if clientSecret != "" {
+			return entity.OAuthClient{}, errx.ErrorOAuthClientUnauthorized.Raise(
+				fmt.Errorf("public oauth client %s sent a secret", client.ID),
+			)
+		}
+
+		return client, nil
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashSecretToken(clientSecret)), []byte(client.SecretHash)) != 1 {
+		return entity.OAuthClient{}, errx.ErrorOAuthClientUnauthorized.Raise(
+			fmt.Errorf("invalid secret for oauth client %s", client.ID),
+		)
+	}
+
+	return client, nil
+}
+
+func (s Service) checkOAuthConsent(
+	ctx context.Context,
+	accountID uuid.UUID,
+	client entity.OAuthClient,
+	params AuthorizeParams,
+) error {
+	consent, err := s.db.GetOAuthConsent(ctx, accountID, client.ID)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get consent of account %s for oauth client %s, cause: %w", accountID, client.ID, err),
+		)
+	}
+
+	if consent.Covers(params.Scopes) {
+		return nil
+	}
+
+	if !params.GrantConsent {
+		return errx.ErrorOAuthConsentRequired.Raise(
+			fmt.Errorf("account %s has not consented to scopes %v for oauth client %s", accountID, params.Scopes, client.ID),
+		)
+	}
+
+	scopes := slices.Clone(params.Scopes)
+	for _, scope := range consent.Scopes {
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	_, err = s.db.SaveOAuthConsent(ctx, accountID, client.ID, scopes)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to save consent of account %s for oauth client %s, cause: %w", accountID, client.ID, err),
+		)
+	}
+
+	return nil
+}
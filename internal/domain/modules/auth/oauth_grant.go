@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+type oauthGrantCtxKey struct{}
+
+// OAuthGrant is what the user let an oauth client do, the client and the scopes its
+// tokens carry. The zero grant stands for our own front-ends, which are not limited by
+// scopes.
+type OAuthGrant struct {
+	ClientID string
+	Scopes   []string
+}
+
+func (g OAuthGrant) IsFirstParty() bool {
+	return g.ClientID == ""
+}
+
+func (g OAuthGrant) Allows(scope string) bool {
+	return g.IsFirstParty() || slices.Contains(g.Scopes, scope)
+}
+
+// issuesRefresh tells whether a refresh token is issued with the access token, oauth
+// clients get one only when the user granted offline access.
+func (g OAuthGrant) issuesRefresh() bool {
+	return g.Allows(entity.OAuthScopeOfflineAccess)
+}
+
+func WithOAuthGrant(ctx context.Context, grant OAuthGrant) context.Context {
+	return context.WithValue(ctx, oauthGrantCtxKey{}, grant)
+}
+
+// OAuthGrantFromCtx returns the grant put into the context by WithOAuthGrant, the first
+// party grant when there is none.
+func OAuthGrantFromCtx(ctx context.Context) OAuthGrant {
+	grant, _ := ctx.Value(oauthGrantCtxKey{}).(OAuthGrant)
+	return grant
+}
@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/token"
+)
+
+// IntrospectToken reports whether an access or refresh token still belongs to a live,
+// unexpired session of an active account. Only confidential clients, such as gateways, may ask.
+// Tokens that fail to parse are reported inactive rather than as an error.
+func (s Service) IntrospectToken(
+	ctx context.Context,
+	clientID, clientSecret string,
+	tokenStr, tokenTypeHint string,
+) (entity.TokenIntrospection, error) {
+	client, err := s.authenticateOAuthClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return entity.TokenIntrospection{}, err
+	}
+	if client.IsPublic() {
+		return entity.TokenIntrospection{}, errx.ErrorOAuthClientUnauthorized.Raise(
+			fmt.Errorf("public oauth client %s may not introspect tokens", client.ID),
+		)
+	}
+
+	typ, claims, ok := s.parseAnyAccountToken(tokenStr, tokenTypeHint)
+	if !ok {
+		return entity.TokenIntrospection{}, nil
+	}
+
+	accountID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return entity.TokenIntrospection{}, nil
+	}
+
+	account, err := s.db.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return entity.TokenIntrospection{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get account %s, cause: %w", accountID, err),
+		)
+	}
+	if account.IsNil() || account.CanInteract() != nil {
+		return entity.TokenIntrospection{}, nil
+	}
+
+	session, err := s.db.GetSession(ctx, claims.SessionID)
+	if err != nil {
+		return entity.TokenIntrospection{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get session %s, cause: %w", claims.SessionID, err),
+		)
+	}
+	if session.IsNil() || session.AccountID != account.ID {
+		return entity.TokenIntrospection{}, nil
+	}
+
+	// the reaper deletes an expired session only later, until then it is already inactive
+	if session.CanBeUsed(s.cfg.Sessions.Expiry) != nil {
+		return entity.TokenIntrospection{}, nil
+	}
+
+	if typ == entity.TokenTypeRefresh {
+		current, err := s.isCurrentRefreshToken(ctx, session.ID, tokenStr)
+		if err != nil {
+			return entity.TokenIntrospection{}, err
+		}
+		if !current {
+			return entity.TokenIntrospection{}, nil
+		}
+	}
+
+	introspection := entity.TokenIntrospection{
+		Active:    true,
+		TokenType: typ,
+		AccountID: account.ID,
+		SessionID: session.ID,
+		Role:      account.Role,
+		Username:  account.Username,
+		Issuer:    claims.Issuer,
+		ClientID:  claims.ClientID,
+		Scopes:    claims.Scopes(),
+	}
+	if claims.IssuedAt != nil {
+		introspection.IssuedAt = claims.IssuedAt.Time
+	}
+	if claims.ExpiresAt != nil {
+		introspection.ExpiresAt = claims.ExpiresAt.Time
+	}
+
+	return introspection, nil
+}
+
+// RevokeToken deletes the session behind an access or refresh token, which invalidates
+// every token issued for it. Only confidential clients may revoke, and only the tokens
+// issued to them, RFC 7009 section 2.1. Invalid and already revoked tokens are not an error.
+func (s Service) RevokeToken(
+	ctx context.Context,
+	clientID, clientSecret string,
+	tokenStr, tokenTypeHint string,
+) error {
+	client, err := s.authenticateOAuthClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return err
+	}
+	if client.IsPublic() {
+		return errx.ErrorOAuthClientUnauthorized.Raise(
+			fmt.Errorf("public oauth client %s may not revoke tokens", client.ID),
+		)
+	}
+
+	_, claims, ok := s.parseAnyAccountToken(tokenStr, tokenTypeHint)
+	if !ok {
+		return nil
+	}
+
+	if claims.ClientID != client.ID {
+		return errx.ErrorOAuthGrantInvalid.Raise(
+			fmt.Errorf("token of session %s was not issued to oauth client %s", claims.SessionID, client.ID),
+		)
+	}
+
+	accountID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil
+	}
+
+	err = s.db.DeleteAccountSession(ctx, accountID, claims.SessionID)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to revoke session %s for account %s, cause: %w", claims.SessionID, accountID, err),
+		)
+	}
+
+	return nil
+}
+
+// parseAnyAccountToken parses the token as an access or a refresh token, starting with
+// the type the client hinted at.
+func (s Service) parseAnyAccountToken(tokenStr, tokenTypeHint string) (string, token.AccountClaims, bool) {
+	parsers := []struct {
+		typ   string
+		parse func(string) (token.AccountClaims, error)
+	}{
+		{entity.TokenTypeAccess, s.jwt.ParseAccess},
+		{entity.TokenTypeRefresh, s.jwt.ParseRefreshClaims},
+	}
+	if tokenTypeHint == entity.TokenTypeRefresh {
+		parsers[0], parsers[1] = parsers[1], parsers[0]
+	}
+
+	for _, p := range parsers {
+		claims, err := p.parse(tokenStr)
+		if err == nil {
+			return p.typ, claims, true
+		}
+	}
+
+	return "", token.AccountClaims{}, false
+}
+
+// isCurrentRefreshToken reports whether tokenStr is the latest refresh token of the
+// session, older ones have already been rotated away.
+func (s Service) isCurrentRefreshToken(ctx context.Context, sessionID uuid.UUID, tokenStr string) (bool, error) {
+	stored, err := s.db.GetSessionToken(ctx, sessionID)
+	if err != nil {
+		return false, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get token of session %s, cause: %w", sessionID, err),
+		)
+	}
+	if stored == "" {
+		return false, nil
+	}
+
+	current, err := s.jwt.DecryptRefresh(stored)
+	if err != nil {
+		return false, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to decrypt token of session %s, cause: %w", sessionID, err),
+		)
+	}
+
+	return current == tokenStr, nil
+}
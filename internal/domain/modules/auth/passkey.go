@@ -0,0 +1,235 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+func (s Service) BeginPasskeyRegistration(ctx context.Context, initiator InitiatorData) (entity.PasskeyCeremony, error) {
+	account, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return entity.PasskeyCeremony{}, err
+	}
+
+	email, err := s.GetAccountEmail(ctx, account.ID)
+	if err != nil {
+		return entity.PasskeyCeremony{}, err
+	}
+
+	existing, err := s.getAccountPasskeys(ctx, account.ID)
+	if err != nil {
+		return entity.PasskeyCeremony{}, err
+	}
+
+	options, session, expiresAt, err := s.passkey.BeginRegistration(account, email.Email, existing)
+	if err != nil {
+		return entity.PasskeyCeremony{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to begin passkey registration for account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	return s.createPasskeyCeremony(ctx, account.ID, entity.PasskeyCeremonyRegistration, options, session, expiresAt)
+}
+
+func (s Service) FinishPasskeyRegistration(
+	ctx context.Context,
+	initiator InitiatorData,
+	ceremonyID uuid.UUID,
+	name string,
+	response []byte,
+) (entity.Passkey, error) {
+	account, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return entity.Passkey{}, err
+	}
+
+	ceremony, err := s.consumePasskeyCeremony(ctx, ceremonyID)
+	if err != nil {
+		return entity.Passkey{}, err
+	}
+
+	if err = ceremony.CanBeFinished(entity.PasskeyCeremonyRegistration, account.ID); err != nil {
+		return entity.Passkey{}, err
+	}
+
+	email, err := s.GetAccountEmail(ctx, account.ID)
+	if err != nil {
+		return entity.Passkey{}, err
+	}
+
+	existing, err := s.getAccountPasskeys(ctx, account.ID)
+	if err != nil {
+		return entity.Passkey{}, err
+	}
+
+	passkey, err := s.passkey.FinishRegistration(account, email.Email, existing, ceremony.Session, response)
+	if err != nil {
+		return entity.Passkey{}, errx.ErrorPasskeyInvalid.Raise(
+			fmt.Errorf("failed to finish passkey registration for account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	passkey.Name = name
+
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		passkey, err = s.db.CreatePasskey(ctx, passkey)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to save passkey for account %s, cause: %w", account.ID, err),
+			)
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionPasskeyRegistered, account.ID, account.ID, map[string]any{
+			"passkey_id": passkey.ID,
+		})
+	})
+	if err != nil {
+		return entity.Passkey{}, err
+	}
+
+	return passkey, nil
+}
+
+func (s Service) GetOwnPasskeys(ctx context.Context, initiator InitiatorData) ([]entity.Passkey, error) {
+	_, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getAccountPasskeys(ctx, initiator.AccountID)
+}
+
+func (s Service) DeleteOwnPasskey(ctx context.Context, initiator InitiatorData, passkeyID uuid.UUID) error {
+	_, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return err
+	}
+
+	passkey, err := s.db.GetAccountPasskey(ctx, initiator.AccountID, passkeyID)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get passkey %s for account %s, cause: %w", passkeyID, initiator.AccountID, err),
+		)
+	}
+	if passkey.IsNil() {
+		return errx.ErrorPasskeyNotFound.Raise(
+			fmt.Errorf("passkey %s not found for account %s", passkeyID, initiator.AccountID),
+		)
+	}
+
+	return s.UnitOfWork(ctx, func(ctx context.Context) error {
+		err = s.db.DeleteAccountPasskey(ctx, initiator.AccountID, passkeyID)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to delete passkey %s for account %s, cause: %w", passkeyID, initiator.AccountID, err),
+			)
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionPasskeyDeleted, initiator.AccountID, initiator.AccountID, map[string]any{
+			"passkey_id": passkeyID,
+		})
+	})
+}
+
+func (s Service) BeginPasskeyLogin(ctx context.Context) (entity.PasskeyCeremony, error) {
+	options, session, expiresAt, err := s.passkey.BeginLogin()
+	if err != nil {
+		return entity.PasskeyCeremony{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to begin passkey login, cause: %w", err),
+		)
+	}
+
+	return s.createPasskeyCeremony(ctx, uuid.Nil, entity.PasskeyCeremonyLogin, options, session, expiresAt)
+}
+
+// FinishPasskeyLogin verifies the assertion for a login ceremony and opens a session for
+// the account owning the passkey. A passkey is a second factor on its own, so no mfa
+// challenge follows.
+func (s Service) FinishPasskeyLogin(
+	ctx context.Context,
+	ceremonyID uuid.UUID,
+	response []byte,
+) (entity.TokensPair, error) {
+	ceremony, err := s.consumePasskeyCeremony(ctx, ceremonyID)
+	if err != nil {
+		return entity.TokensPair{}, err
+	}
+
+	if err = ceremony.CanBeFinished(entity.PasskeyCeremonyLogin, uuid.Nil); err != nil {
+		return entity.TokensPair{}, err
+	}
+
+	passkey, err := s.passkey.FinishLogin(ceremony.Session, response, func(accountID uuid.UUID) ([]entity.Passkey, error) {
+		return s.db.GetAccountPasskeys(ctx, accountID)
+	})
+	if err != nil {
+		return entity.TokensPair{}, errx.ErrorPasskeyInvalid.Raise(
+			fmt.Errorf("failed to finish passkey login, cause: %w", err),
+		)
+	}
+
+	account, err := s.GetAccountByID(ctx, passkey.AccountID)
+	if err != nil {
+		return entity.TokensPair{}, err
+	}
+
+	if err = account.CanInteract(); err != nil {
+		return entity.TokensPair{}, err
+	}
+
+	_, err = s.db.UpdatePasskeyUsage(ctx, passkey.ID, passkey.SignCount, passkey.BackupState)
+	if err != nil {
+		return entity.TokensPair{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to update passkey %s usage, cause: %w", passkey.ID, err),
+		)
+	}
+
+	return s.createSession(ctx, account)
+}
+
+func (s Service) getAccountPasskeys(ctx context.Context, accountID uuid.UUID) ([]entity.Passkey, error) {
+	passkeys, err := s.db.GetAccountPasskeys(ctx, accountID)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get passkeys for account %s, cause: %w", accountID, err),
+		)
+	}
+
+	return passkeys, nil
+}
+
+func (s Service) createPasskeyCeremony(
+	ctx context.Context,
+	accountID uuid.UUID,
+	kind string,
+	options []byte,
+	session []byte,
+	expiresAt time.Time,
+) (entity.PasskeyCeremony, error) {
+	ceremony, err := s.db.CreatePasskeyCeremony(ctx, accountID, kind, session, expiresAt)
+	if err != nil {
+		return entity.PasskeyCeremony{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to save %s passkey ceremony, cause: %w", kind, err),
+		)
+	}
+
+	ceremony.Options = options
+
+	return ceremony, nil
+}
+
+func (s Service) consumePasskeyCeremony(ctx context.Context, ceremonyID uuid.UUID) (entity.PasskeyCeremony, error) {
+	ceremony, err := s.db.ConsumePasskeyCeremony(ctx, ceremonyID)
+	if err != nil {
+		return entity.PasskeyCeremony{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get passkey ceremony %s, cause: %w", ceremonyID, err),
+		)
+	}
+
+	return ceremony, nil
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (s Service) RequestPasswordReset(ctx context.Context, email string) error {
+	account, err := s.GetAccountByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	if err = account.CanInteract(); err != nil {
+		return err
+	}
+
+	token, err := generateSecretToken()
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to generate password reset token for account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	return s.UnitOfWork(ctx, func(ctx context.Context) error {
+		resetToken, err := s.db.CreatePasswordResetToken(
+			ctx,
+			account.ID,
+			hashSecretToken(token),
+			time.Now().UTC().Add(entity.PasswordResetTokenLifetime),
+		)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to save password reset token for account %s, cause: %w", account.ID, err),
+			)
+		}
+
+		err = s.event.WriteAccountPasswordResetRequested(ctx, account, email, token, resetToken.ExpiresAt)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to publish password reset requested event for account %s, cause: %w", account.ID, err),
+			)
+		}
+
+		return nil
+	})
+}
+
+func (s Service) ResetPassword(ctx context.Context, token, newPassword string) error {
+	resetToken, err := s.db.GetPasswordResetToken(ctx, hashSecretToken(token))
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get password reset token, cause: %w", err),
+		)
+	}
+	if resetToken.IsNil() {
+		return errx.ErrorPasswordResetTokenInvalid.Raise(
+			fmt.Errorf("password reset token not found"),
+		)
+	}
+
+	if err = resetToken.CanBeUsed(); err != nil {
+		return err
+	}
+
+	account, err := s.GetAccountByID(ctx, resetToken.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if err = account.CanInteract(); err != nil {
+		return err
+	}
+
+	if err = s.CheckPasswordRequirements(newPassword); err != nil {
+		return err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("hashing new password for account '%s', cause: %w", account.ID, err),
+		)
+	}
+
+	email, err := s.GetAccountEmail(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		if _, err = s.db.ResetAccountPassword(ctx, resetToken.ID, account.ID, string(hash)); err != nil {
+			return err
+		}
+
+		if err = s.writeAudit(ctx, entity.AuditActionPasswordReset, uuid.Nil, account.ID, nil); err != nil {
+			return err
+		}
+
+		return s.event.WriteAccountPasswordChanged(ctx, account, email.Email)
+	})
+	if errors.Is(err, errx.ErrorPasswordResetTokenInvalid) {
+		return err
+	}
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to reset password for account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	return nil
+}
+
+// generateSecretToken returns a random url-safe token which is sent to the user,
+// only its hash is stored in the database.
+func generateSecretToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashSecretToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
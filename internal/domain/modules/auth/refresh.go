@@ -10,87 +10,152 @@ import (
 )
 
 func (s Service) Refresh(ctx context.Context, oldRefreshToken string) (entity.TokensPair, error) {
+	pair, _, err := s.refreshSession(ctx, oldRefreshToken, "")
+	return pair, err
+}
+
+// refreshSession rotates the tokens of a session. clientID is the oauth client presenting
+// the refresh token, empty for our own front-ends, the token and its session must have
+// been issued to it. It returns the new tokens with the grant they carry.
+func (s Service) refreshSession(
+	ctx context.Context,
+	oldRefreshToken, clientID string,
+) (entity.TokensPair, OAuthGrant, error) {
 	tokenData, err := s.jwt.ParseRefreshClaims(oldRefreshToken)
 	if err != nil {
-		return entity.TokensPair{}, errx.ErrorInternal.Raise(
+		return entity.TokensPair{}, OAuthGrant{}, errx.ErrorInternal.Raise(
 			fmt.Errorf("failed to decrypt refresh token claims, cause: %w", err),
 		)
 	}
 
+	if tokenData.ClientID != clientID {
+		return entity.TokensPair{}, OAuthGrant{}, errx.ErrorSessionNotFound.Raise(
+			fmt.Errorf("refresh token of session %s was not issued to client %q", tokenData.SessionID, clientID),
+		)
+	}
+
 	accountID, err := uuid.Parse(tokenData.Subject)
 	if err != nil {
-		return entity.TokensPair{}, errx.ErrorInternal.Raise(
+		return entity.TokensPair{}, OAuthGrant{}, errx.ErrorInternal.Raise(
 			fmt.Errorf("failed to parse account id from token claims, cause: %w", err),
 		)
 	}
 
 	account, err := s.GetAccountByID(ctx, accountID)
 	if err != nil {
-		return entity.TokensPair{}, err
+		return entity.TokensPair{}, OAuthGrant{}, err
 	}
 
 	if err = account.CanInteract(); err != nil {
-		return entity.TokensPair{}, err
+		return entity.TokensPair{}, OAuthGrant{}, err
 	}
 
 	token, err := s.db.GetSessionToken(ctx, tokenData.SessionID)
 	if err != nil {
-		return entity.TokensPair{}, errx.ErrorInternal.Raise(
+		return entity.TokensPair{}, OAuthGrant{}, errx.ErrorInternal.Raise(
 			fmt.Errorf("failed to get session with id: %s for account %s, cause: %w", tokenData.SessionID, accountID, err),
 		)
 	}
 	if token == "" {
-		return entity.TokensPair{}, errx.ErrorSessionNotFound.Raise(
+		return entity.TokensPair{}, OAuthGrant{}, errx.ErrorSessionNotFound.Raise(
 			fmt.Errorf("failed to find session with id %s for account %s, cause: %w", tokenData.SessionID, accountID, err),
 		)
 	}
 
 	refresh, err := s.jwt.DecryptRefresh(token)
 	if err != nil {
-		return entity.TokensPair{}, errx.ErrorInternal.Raise(
+		return entity.TokensPair{}, OAuthGrant{}, errx.ErrorInternal.Raise(
 			fmt.Errorf("failed to generate refresh token for account %s, cause: %w", accountID, err),
 		)
 	}
 	if refresh != oldRefreshToken {
-		return entity.TokensPair{}, errx.ErrorSessionTokenMismatch.Raise(
-			fmt.Errorf(
-				"refresh token does not match for session %s and account %s, cause: %w",
-				tokenData.SessionID, accountID, err,
-			),
-		)
+		return entity.TokensPair{}, OAuthGrant{}, s.revokeCompromisedSession(ctx, account, tokenData.SessionID)
 	}
 
-	refresh, err = s.jwt.GenerateRefresh(account, tokenData.SessionID)
+	session, err := s.db.GetSession(ctx, tokenData.SessionID)
 	if err != nil {
-		return entity.TokensPair{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("failed to generate refresh token for account %s, cause: %w", accountID, err),
+		return entity.TokensPair{}, OAuthGrant{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get session with id: %s for account %s, cause: %w", tokenData.SessionID, accountID, err),
+		)
+	}
+	if session.IsNil() || session.OAuthClientID != clientID {
+		return entity.TokensPair{}, OAuthGrant{}, errx.ErrorSessionNotFound.Raise(
+			fmt.Errorf("failed to find session with id %s for account %s and client %q", tokenData.SessionID, accountID, clientID),
 		)
 	}
 
-	refreshCrypto, err := s.jwt.EncryptRefresh(refresh)
+	// the sweeper deletes it and publishes the expired event later
+	if err = session.CanBeUsed(s.cfg.Sessions.Expiry); err != nil {
+		return entity.TokensPair{}, OAuthGrant{}, err
+	}
+
+	grant := OAuthGrant{
+		ClientID: tokenData.ClientID,
+		Scopes:   tokenData.Scopes(),
+	}
+
+	pair, err := s.createTokensPair(tokenData.SessionID, account, grant)
 	if err != nil {
-		return entity.TokensPair{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("failed to encrypt refresh token for account %s, cause: %w", accountID, err),
-		)
+		return entity.TokensPair{}, OAuthGrant{}, err
 	}
 
-	access, err := s.jwt.GenerateAccess(account, tokenData.SessionID)
+	refreshCrypto, err := s.encryptRefresh(account, pair.Refresh)
 	if err != nil {
-		return entity.TokensPair{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("failed to generate access token for account %s, cause: %w", accountID, err),
-		)
+		return entity.TokensPair{}, OAuthGrant{}, err
 	}
 
-	_, err = s.db.UpdateSessionToken(ctx, tokenData.SessionID, refreshCrypto)
+	rotated, err := s.db.RotateSessionToken(ctx, session.ID, session.Generation, refreshCrypto, ClientDataFromCtx(ctx).IP)
 	if err != nil {
-		return entity.TokensPair{}, errx.ErrorInternal.Raise(
+		return entity.TokensPair{}, OAuthGrant{}, errx.ErrorInternal.Raise(
 			fmt.Errorf("failed to save refresh token for account %s, cause: %w", accountID, err),
 		)
 	}
+	if rotated.IsNil() {
+		// a concurrent refresh has already rotated the same token
+		return entity.TokensPair{}, OAuthGrant{}, s.revokeCompromisedSession(ctx, account, session.ID)
+	}
+
+	return pair, grant, nil
+}
+
+// revokeCompromisedSession handles a refresh token that has already been rotated. Only
+// the legitimate client or the attacker can hold the current token, so the whole
+// session family is revoked and both have to log in again.
+func (s Service) revokeCompromisedSession(ctx context.Context, account entity.Account, sessionID uuid.UUID) error {
+	email, err := s.GetAccountEmail(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		if s.cfg.Sessions.RevokeAllOnTokenReuse {
+			err = s.db.DeleteSessionsForAccount(ctx, account.ID)
+		} else {
+			err = s.db.DeleteSession(ctx, sessionID)
+		}
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to revoke compromised session %s for account %s, cause: %w", sessionID, account.ID, err),
+			)
+		}
+
+		err = s.event.WriteAccountSessionCompromised(ctx, account, email.Email, sessionID, s.cfg.Sessions.RevokeAllOnTokenReuse)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to publish session compromised event for account %s: %w", account.ID, err),
+			)
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionSessionCompromised, uuid.Nil, account.ID, map[string]any{
+			"session_id":  sessionID,
+			"revoked_all": s.cfg.Sessions.RevokeAllOnTokenReuse,
+		})
+	})
+	if err != nil {
+		return err
+	}
 
-	return entity.TokensPair{
-		SessionID: tokenData.SessionID,
-		Refresh:   refresh,
-		Access:    access,
-	}, nil
+	return errx.ErrorSessionTokenMismatch.Raise(
+		fmt.Errorf("refresh token reuse detected for session %s and account %s, session revoked", sessionID, account.ID),
+	)
 }
@@ -66,23 +66,31 @@ func (s Service) Registration(
 		)
 	}
 
-	account, err := s.db.CreateAccount(ctx, CreateAccountParams{
-		Username:     params.Username,
-		Role:         params.Role,
-		Email:        params.Email,
-		PasswordHash: string(hash),
+	var account entity.Account
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		account, err = s.db.CreateAccount(ctx, CreateAccountParams{
+			Username:     params.Username,
+			Role:         params.Role,
+			Email:        params.Email,
+			PasswordHash: string(hash),
+		})
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to inserting new account with email '%s', cause: %w", params.Email, err),
+			)
+		}
+
+		err = s.event.WriteAccountCreated(ctx, account, params.Email, entity.AccountSourceRegistration)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to publish account created event for account '%s', cause: %w", account.ID, err),
+			)
+		}
+
+		return s.sendEmailVerification(ctx, account, params.Email)
 	})
 	if err != nil {
-		return entity.Account{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("failed to inserting new account with email '%s', cause: %w", params.Email, err),
-		)
-	}
-
-	err = s.event.WriteAccountCreated(ctx, account, params.Email)
-	if err != nil {
-		return entity.Account{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("failed to publish account created event for account '%s', cause: %w", account.ID, err),
-		)
+		return entity.Account{}, err
 	}
 
 	return account, nil
@@ -115,17 +123,32 @@ func (s Service) RegistrationByAdmin(
 		return entity.Account{}, err
 	}
 
-	account, err := s.Registration(ctx, params)
+	var account entity.Account
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		account, err = s.Registration(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		err = s.writeAudit(ctx, entity.AuditActionAccountRegisteredByAdmin, initiatorID, account.ID, map[string]any{
+			"role": params.Role,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = s.event.WriteAccountCreated(ctx, account, params.Email, entity.AccountSourceAdmin)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to publish admin created event for account '%s', cause: %w", account.ID, err),
+			)
+		}
+
+		return nil
+	})
 	if err != nil {
 		return entity.Account{}, err
 	}
 
-	err = s.event.WriteAccountCreated(ctx, account, params.Email)
-	if err != nil {
-		return entity.Account{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("failed to publish admin created event for account '%s', cause: %w", account.ID, err),
-		)
-	}
-
 	return account, nil
 }
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/umisto/restkit/roles"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+// The changes below are requested by other services through the inbox, there is no
+// session to check. Each of them is safe to repeat, an event may be handled again after
+// a failure.
+
+// ConfirmEmailVerified marks the email verified when another service confirmed it. An
+// email changed in the meantime stays unverified.
+func (s Service) ConfirmEmailVerified(ctx context.Context, accountID uuid.UUID, email string) error {
+	emailData, err := s.GetAccountEmail(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if emailData.Verified || emailData.Email != email {
+		return nil
+	}
+
+	_, err = s.db.UpdateAccountEmailVerification(ctx, accountID, true)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("verifying email for account %s, cause: %w", accountID, err),
+		)
+	}
+
+	return nil
+}
+
+// SuspendAccountByAdmin suspends the account on the request of an admin made in another
+// service, the sessions of the account are revoked with it.
+func (s Service) SuspendAccountByAdmin(ctx context.Context, initiatorID, accountID uuid.UUID, reason string) error {
+	if err := s.validateAdminAccount(ctx, initiatorID); err != nil {
+		return err
+	}
+
+	if accountID == initiatorID {
+		return errx.ErrorCannotManageOwnAccount.Raise(
+			fmt.Errorf("admin %s cannot suspend the own account", initiatorID),
+		)
+	}
+
+	account, err := s.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if account.Status == entity.AccountStatusSuspended {
+		return nil
+	}
+
+	email, err := s.GetAccountEmail(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.updateAccountStatus(ctx, InitiatorData{AccountID: initiatorID}, account, email.Email,
+		entity.AccountStatusSuspended, reason)
+
+	return err
+}
+
+// DeleteAccountByRequest deletes the account on the request of its user or of an admin,
+// initiatorID is nil for the user.
+func (s Service) DeleteAccountByRequest(ctx context.Context, initiatorID, accountID uuid.UUID, reason string) error {
+	actorID := accountID
+	if initiatorID != uuid.Nil && initiatorID != accountID {
+		if err := s.validateAdminAccount(ctx, initiatorID); err != nil {
+			return err
+		}
+		actorID = initiatorID
+	}
+
+	account, err := s.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		if err = s.db.DeleteAccount(ctx, account.ID); err != nil {
+			return err
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionAccountDeleted, actorID, account.ID, map[string]any{
+			"reason": reason,
+		})
+	})
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to delete account with id: %s, cause: %w", account.ID, err),
+		)
+	}
+
+	return nil
+}
+
+// validateAdminAccount checks that the account making a request is an active system
+// admin, like validateAdmin without a session.
+func (s Service) validateAdminAccount(ctx context.Context, accountID uuid.UUID) error {
+	account, err := s.db.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get initiator with id '%s', cause: %w", accountID, err),
+		)
+	}
+	if account.IsNil() {
+		return errx.ErrorInitiatorNotFound.Raise(
+			fmt.Errorf("initiator with id '%s' not found", accountID),
+		)
+	}
+
+	if err = account.CanInteract(); err != nil {
+		return err
+	}
+
+	if account.Role != roles.SystemAdmin {
+		return errx.ErrorNotEnoughRights.Raise(
+			fmt.Errorf("account %s has insufficient permissions to manage accounts", accountID),
+		)
+	}
+
+	return nil
+}
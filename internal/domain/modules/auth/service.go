@@ -2,21 +2,46 @@ package auth
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/google/uuid"
-	"github.com/umisto/restkit/token"
 	"github.com/umisto/sso-svc/internal/domain/entity"
 	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/token"
 )
 
+type PasskeyRelyingParty interface {
+	BeginRegistration(
+		account entity.Account,
+		name string,
+		existing []entity.Passkey,
+	) (options json.RawMessage, session []byte, expiresAt time.Time, err error)
+	FinishRegistration(
+		account entity.Account,
+		name string,
+		existing []entity.Passkey,
+		session []byte,
+		response []byte,
+	) (entity.Passkey, error)
+
+	BeginLogin() (options json.RawMessage, session []byte, expiresAt time.Time, err error)
+	FinishLogin(
+		session []byte,
+		response []byte,
+		lookup func(accountID uuid.UUID) ([]entity.Passkey, error),
+	) (entity.Passkey, error)
+}
+
 type JWTManager interface {
 	EncryptAccess(token string) (string, error)
 	EncryptRefresh(token string) (string, error)
 	DecryptRefresh(encryptedToken string) (string, error)
 
+	ParseAccess(tokenStr string) (token.AccountClaims, error)
 	ParseRefreshClaims(enc string) (token.AccountClaims, error)
 
 	GenerateAccess(
@@ -26,23 +51,142 @@ type JWTManager interface {
 	GenerateRefresh(
 		account entity.Account, sessionID uuid.UUID,
 	) (string, error)
+
+	GenerateClientAccess(
+		account entity.Account, sessionID uuid.UUID, clientID string, scopes []string,
+	) (string, error)
+
+	GenerateClientRefresh(
+		account entity.Account, sessionID uuid.UUID, clientID string, scopes []string,
+	) (string, error)
+
+	GenerateEmailVerification(accountID uuid.UUID, email string) (string, time.Time, error)
+	ParseEmailVerification(code string) (uuid.UUID, string, error)
+	GenerateEmailChange(accountID uuid.UUID, email string) (string, time.Time, error)
+	ParseEmailChange(code string) (uuid.UUID, string, error)
+
+	GenerateMFAChallenge(challengeID, accountID uuid.UUID) (string, time.Time, error)
+	ParseMFAChallenge(challenge string) (uuid.UUID, uuid.UUID, error)
+
+	GenerateTOTPSecret() (string, error)
+	TOTPURI(secret, accountName string) string
+	EncryptTOTPSecret(secret string) (string, error)
+	DecryptTOTPSecret(encryptedSecret string) (string, error)
+	ValidateTOTP(secret, code string, at time.Time) (int64, bool)
+
+	GenerateIDToken(account entity.Account, email entity.AccountEmail, params token.IDTokenParams) (string, error)
 }
 
 type EventPublisher interface {
-	WriteAccountCreated(ctx context.Context, account entity.Account, email string) error
+	WriteAccountCreated(ctx context.Context, account entity.Account, email, source string) error
 	WriteAccountPasswordChanged(ctx context.Context, account entity.Account, email string) error
 	WriteAccountUsernameChanged(ctx context.Context, account entity.Account, email string) error
-	WriteAccountLogin(ctx context.Context, account entity.Account, email string) error
+	WriteAccountLogin(
+		ctx context.Context,
+		account entity.Account,
+		email string,
+		sessionID uuid.UUID,
+		evictedSessionIDs []uuid.UUID,
+	) error
+	WriteAccountPasswordResetRequested(
+		ctx context.Context,
+		account entity.Account,
+		email string,
+		token string,
+		expiresAt time.Time,
+	) error
+	WriteAccountEmailVerificationRequested(
+		ctx context.Context,
+		account entity.Account,
+		email string,
+		code string,
+		expiresAt time.Time,
+	) error
+	WriteAccountEmailChanged(ctx context.Context, account entity.Account, oldEmail, newEmail string) error
+	WriteAccountLoginFailed(
+		ctx context.Context,
+		account entity.Account,
+		email string,
+		ip string,
+		failedAttempts int32,
+		lockedUntil *time.Time,
+	) error
+	WriteAccountStatusChanged(
+		ctx context.Context,
+		account entity.Account,
+		email string,
+		oldStatus string,
+		reason string,
+		initiatorID uuid.UUID,
+	) error
+	WriteAccountRoleChanged(
+		ctx context.Context,
+		account entity.Account,
+		email string,
+		oldRole string,
+		reason string,
+		initiatorID uuid.UUID,
+	) error
+	WriteAccountSessionCompromised(
+		ctx context.Context,
+		account entity.Account,
+		email string,
+		sessionID uuid.UUID,
+		allSessionsRevoked bool,
+	) error
+	WriteAccountSessionExpired(
+		ctx context.Context,
+		account entity.Account,
+		email string,
+		session entity.Session,
+		reason string,
+	) error
 }
 
 type CreateAccountParams struct {
-	Username     string
-	Role         string
-	Email        string
+	Username      string
+	Role          string
+	Email         string
+	EmailVerified bool
+	// PasswordHash is empty for an account without a password, no password matches it.
 	PasswordHash string
+	// Identity is linked to the account in the same transaction when set.
+	Identity *entity.SocialIdentity
+}
+
+// SessionsFilter selects the sessions revoked by an admin, zero fields do not filter.
+// ExceptSessionID keeps the session of the admin revoking alive.
+type SessionsFilter struct {
+	Role            string
+	CreatedBefore   *time.Time
+	ExceptSessionID uuid.UUID
+}
+
+// AuditLogFilter narrows the audit log, zero fields do not filter. AccountID selects the
+// entries the account made or that were made to it.
+type AuditLogFilter struct {
+	ActorID       uuid.UUID
+	TargetID      uuid.UUID
+	AccountID     uuid.UUID
+	Action        string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// AccountsFilter narrows the accounts listed to admins, zero fields do not filter.
+type AccountsFilter struct {
+	Status         string
+	Role           string
+	EmailPrefix    string
+	UsernamePrefix string
+	CreatedAfter   *time.Time
+	CreatedBefore  *time.Time
 }
 
 type database interface {
+	// Transaction runs fn in a transaction, the calls made with the context fn gets join it.
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
 	CreateAccount(
 		ctx context.Context,
 		params CreateAccountParams,
@@ -62,6 +206,8 @@ type database interface {
 		accountID uuid.UUID,
 		status string,
 	) (entity.Account, error)
+	UpdateAccountRole(ctx context.Context, accountID uuid.UUID, role string) (entity.Account, error)
+	GetAccounts(ctx context.Context, filter AccountsFilter, page, size int32) (entity.AccountsCollection, error)
 
 	GetAccountEmail(ctx context.Context, accountID uuid.UUID) (entity.AccountEmail, error)
 	UpdateAccountEmailVerification(
@@ -69,6 +215,11 @@ type database interface {
 		accountID uuid.UUID,
 		verified bool,
 	) (entity.AccountEmail, error)
+	UpdateAccountEmailVerificationSentAt(
+		ctx context.Context,
+		accountID uuid.UUID,
+		sentAt time.Time,
+	) (entity.AccountEmail, error)
 
 	GetAccountPassword(ctx context.Context, accountID uuid.UUID) (entity.AccountPassword, error)
 	UpdateAccountPassword(
@@ -78,8 +229,14 @@ type database interface {
 	) (entity.AccountPassword, error)
 	DeleteAccount(ctx context.Context, accountID uuid.UUID) error
 
-	CreateSession(ctx context.Context, sessionID, accountID uuid.UUID, hashToken string) (entity.Session, error)
+	CreateSession(
+		ctx context.Context,
+		sessionID, accountID uuid.UUID,
+		hashToken string,
+		client entity.SessionClient,
+	) (entity.Session, error)
 	GetSession(ctx context.Context, sessionID uuid.UUID) (entity.Session, error)
+	LockAccountSessions(ctx context.Context, accountID uuid.UUID) ([]entity.Session, error)
 	GetAccountSession(
 		ctx context.Context,
 		accountID, sessionID uuid.UUID,
@@ -90,35 +247,200 @@ type database interface {
 		page, size int32,
 	) (entity.SessionsCollection, error)
 	GetSessionToken(ctx context.Context, sessionID uuid.UUID) (string, error)
-	UpdateSessionToken(
+	RotateSessionToken(
 		ctx context.Context,
 		sessionID uuid.UUID,
+		generation int64,
 		token string,
+		ip string,
 	) (entity.Session, error)
 
 	DeleteSession(ctx context.Context, sessionID uuid.UUID) error
 	DeleteSessionsForAccount(ctx context.Context, accountID uuid.UUID) error
 	DeleteAccountSession(ctx context.Context, accountID, sessionID uuid.UUID) error
+	DeleteSessions(ctx context.Context, filter SessionsFilter) (int64, error)
+	DeleteExpiredSessions(
+		ctx context.Context,
+		lastUsedBefore, createdBefore *time.Time,
+		limit uint64,
+	) ([]entity.Session, error)
+
+	CreatePasswordResetToken(
+		ctx context.Context,
+		accountID uuid.UUID,
+		tokenHash string,
+		expiresAt time.Time,
+	) (entity.PasswordResetToken, error)
+	GetPasswordResetToken(ctx context.Context, tokenHash string) (entity.PasswordResetToken, error)
+	ResetAccountPassword(
+		ctx context.Context,
+		tokenID, accountID uuid.UUID,
+		passwordHash string,
+	) (entity.AccountPassword, error)
+
+	CreateAccountEmailChange(
+		ctx context.Context,
+		accountID uuid.UUID,
+		newEmail string,
+		expiresAt time.Time,
+	) (entity.AccountEmailChange, error)
+	GetAccountEmailChange(ctx context.Context, accountID uuid.UUID) (entity.AccountEmailChange, error)
+	ConfirmAccountEmailChange(
+		ctx context.Context,
+		accountID uuid.UUID,
+		newEmail string,
+	) (entity.AccountEmail, error)
+
+	GetAccountMFA(ctx context.Context, accountID uuid.UUID) (entity.AccountMFA, error)
+	CreateAccountMFA(ctx context.Context, accountID uuid.UUID, totpSecret string) (entity.AccountMFA, error)
+	EnableAccountMFA(
+		ctx context.Context,
+		accountID uuid.UUID,
+		totpStep int64,
+		recoveryCodeHashes []string,
+	) (entity.AccountMFA, error)
+	UseAccountMFATOTPStep(ctx context.Context, accountID uuid.UUID, step int64) (bool, error)
+	UseAccountMFARecoveryCode(ctx context.Context, accountID uuid.UUID, codeHash string) (bool, error)
+	DeleteAccountMFA(ctx context.Context, accountID uuid.UUID) error
+
+	CreateMFAChallenge(
+		ctx context.Context,
+		challengeID, accountID uuid.UUID,
+		expiresAt time.Time,
+	) (entity.MFAChallenge, error)
+	CountMFAChallengeAttempt(
+		ctx context.Context,
+		challengeID uuid.UUID,
+		maxAttempts int32,
+	) (entity.MFAChallenge, error)
+	ConsumeMFAChallenge(ctx context.Context, challengeID uuid.UUID) (entity.MFAChallenge, error)
+
+	CreatePasskey(ctx context.Context, passkey entity.Passkey) (entity.Passkey, error)
+	GetAccountPasskeys(ctx context.Context, accountID uuid.UUID) ([]entity.Passkey, error)
+	GetAccountPasskey(ctx context.Context, accountID, passkeyID uuid.UUID) (entity.Passkey, error)
+	UpdatePasskeyUsage(
+		ctx context.Context,
+		passkeyID uuid.UUID,
+		signCount uint32,
+		backupState bool,
+	) (entity.Passkey, error)
+	DeleteAccountPasskey(ctx context.Context, accountID, passkeyID uuid.UUID) error
+
+	CreateAccountIdentity(
+		ctx context.Context,
+		accountID uuid.UUID,
+		identity entity.SocialIdentity,
+	) (entity.AccountIdentity, error)
+	GetAccountIdentityBySubject(ctx context.Context, provider, subject string) (entity.AccountIdentity, error)
+	GetAccountIdentities(ctx context.Context, accountID uuid.UUID) ([]entity.AccountIdentity, error)
+	GetAccountIdentity(ctx context.Context, accountID, identityID uuid.UUID) (entity.AccountIdentity, error)
+	DeleteAccountIdentity(ctx context.Context, accountID, identityID uuid.UUID) error
+
+	CreateSocialLoginState(ctx context.Context, state entity.SocialLoginState) (entity.SocialLoginState, error)
+	ConsumeSocialLoginState(ctx context.Context, stateHash string) (entity.SocialLoginState, error)
+
+	CreatePasskeyCeremony(
+		ctx context.Context,
+		accountID uuid.UUID,
+		kind string,
+		session []byte,
+		expiresAt time.Time,
+	) (entity.PasskeyCeremony, error)
+	ConsumePasskeyCeremony(ctx context.Context, ceremonyID uuid.UUID) (entity.PasskeyCeremony, error)
+
+	GetLoginThrottle(ctx context.Context, kind, subject string) (entity.LoginThrottle, error)
+	RegisterLoginFailure(
+		ctx context.Context,
+		kind, subject string,
+		policy entity.LoginLockoutPolicy,
+	) (entity.LoginThrottle, error)
+	ResetLoginThrottle(ctx context.Context, kind, subject string) error
+
+	GetOAuthClient(ctx context.Context, clientID string) (entity.OAuthClient, error)
+	CreateOAuthAuthorizationCode(
+		ctx context.Context,
+		code entity.OAuthAuthorizationCode,
+	) (entity.OAuthAuthorizationCode, error)
+	ConsumeOAuthAuthorizationCode(ctx context.Context, codeHash string) (entity.OAuthAuthorizationCode, error)
+	GetOAuthConsent(ctx context.Context, accountID uuid.UUID, clientID string) (entity.OAuthConsent, error)
+	SaveOAuthConsent(
+		ctx context.Context,
+		accountID uuid.UUID,
+		clientID string,
+		scopes []string,
+	) (entity.OAuthConsent, error)
+
+	CreateAuditLogEntry(ctx context.Context, entry entity.AuditLogEntry) error
+	GetAuditLog(ctx context.Context, filter AuditLogFilter, cursor uuid.UUID, limit uint64) ([]entity.AuditLogEntry, error)
+}
+
+type Config struct {
+	Lockout  LockoutConfig
+	Sessions SessionsConfig
+	OAuth    OAuthConfig
+	Social   SocialConfig
+}
+
+// LockoutConfig holds the failed password attempt limits per account and per client ip.
+type LockoutConfig struct {
+	Account entity.LoginLockoutPolicy
+	IP      entity.LoginLockoutPolicy
+}
+
+type SessionsConfig struct {
+	// RevokeAllOnTokenReuse revokes every session of the account, not only the affected
+	// one, when an already rotated refresh token is presented.
+	RevokeAllOnTokenReuse bool
+	// Expiry ends sessions idle or open for too long, the sessions it ends are rejected
+	// at once and deleted by DeleteExpiredSessions.
+	Expiry entity.SessionExpiry
+	// Limit caps the sessions an account holds at once, enforced on every login.
+	Limit entity.SessionLimitPolicy
+}
+
+type OAuthConfig struct {
+	AuthorizationCodeTTL time.Duration
+}
+
+type SocialConfig struct {
+	// AutoProvision creates an account on the first login of an unknown provider user.
+	AutoProvision bool
+	StateTTL      time.Duration
+	// RedirectURIs lists the pages the user agent may be sent to after the callback.
+	RedirectURIs []string
 }
 
 type Service struct {
-	db    database
-	jwt   JWTManager
-	event EventPublisher
+	db      database
+	jwt     JWTManager
+	event   EventPublisher
+	passkey PasskeyRelyingParty
+	cfg     Config
 }
 
 func NewService(
 	db database,
 	jwt JWTManager,
 	event EventPublisher,
+	passkey PasskeyRelyingParty,
+	cfg Config,
 ) *Service {
 	return &Service{
-		db:    db,
-		jwt:   jwt,
-		event: event,
+		db:      db,
+		jwt:     jwt,
+		event:   event,
+		passkey: passkey,
+		cfg:     cfg,
 	}
 }
 
+// UnitOfWork runs fn in one transaction. The changes made through the service within fn
+// and the events it publishes commit together or roll back together, a unit of work
+// started within fn joins the outer one.
+func (s Service) UnitOfWork(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.db.Transaction(ctx, fn)
+}
+
 func (s Service) CheckPasswordRequirements(password string) error {
 	if len(password) < 8 || len(password) > 32 {
 		return errx.ErrorPasswordIsNotAllowed.Raise(
@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+type testTxCtxKey struct{}
+
+// testDatabase fakes the calls createSession makes, any other call panics on the nil
+// embedded interface.
+type testDatabase struct {
+	database
+
+	transactions int
+	sessions     []entity.Session
+}
+
+func (db *testDatabase) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	db.transactions++
+	return fn(context.WithValue(ctx, testTxCtxKey{}, true))
+}
+
+func (db *testDatabase) GetAccountEmail(_ context.Context, accountID uuid.UUID) (entity.AccountEmail, error) {
+	return entity.AccountEmail{AccountID: accountID, Email: "user@example.com", Verified: true}, nil
+}
+
+func (db *testDatabase) LockAccountSessions(ctx context.Context, _ uuid.UUID) ([]entity.Session, error) {
+	if ctx.Value(testTxCtxKey{}) == nil {
+		panic("LockAccountSessions called outside of a transaction")
+	}
+
+	return db.sessions, nil
+}
+
+func (db *testDatabase) CreateSession(
+	ctx context.Context,
+	sessionID, accountID uuid.UUID,
+	hashToken string,
+	client entity.SessionClient,
+) (entity.Session, error) {
+	if ctx.Value(testTxCtxKey{}) == nil {
+		panic("CreateSession called outside of a transaction")
+	}
+
+	session := entity.Session{ID: sessionID, AccountID: accountID, SessionClient: client}
+	db.sessions = append(db.sessions, session)
+
+	return session, nil
+}
+
+type testJWTManager struct {
+	JWTManager
+}
+
+func (testJWTManager) GenerateAccess(account entity.Account, sessionID uuid.UUID) (string, error) {
+	return "access:" + sessionID.String(), nil
+}
+
+func (testJWTManager) GenerateRefresh(account entity.Account, sessionID uuid.UUID) (string, error) {
+	return "refresh:" + sessionID.String(), nil
+}
+
+func (testJWTManager) EncryptRefresh(token string) (string, error) {
+	return "encrypted:" + token, nil
+}
+
+type testEventPublisher struct {
+	EventPublisher
+
+	logins int
+}
+
+func (e *testEventPublisher) WriteAccountLogin(
+	ctx context.Context,
+	_ entity.Account,
+	_ string,
+	_ uuid.UUID,
+	_ []uuid.UUID,
+) error {
+	if ctx.Value(testTxCtxKey{}) == nil {
+		panic("WriteAccountLogin called outside of a transaction")
+	}
+
+	e.logins++
+	return nil
+}
+
+func TestCreateSessionRunsInOneTransaction(t *testing.T) {
+	db := &testDatabase{}
+	events := &testEventPublisher{}
+	s := NewService(db, testJWTManager{}, events, nil, Config{})
+
+	account := entity.Account{ID: uuid.New(), Role: "user"}
+
+	pair, err := s.createSession(context.Background(), account)
+	if err != nil {
+		t.Fatalf("createSession: %v", err)
+	}
+
+	if db.transactions != 1 {
+		t.Fatalf("createSession: expected 1 transaction, got %d", db.transactions)
+	}
+	if len(db.sessions) != 1 || db.sessions[0].ID != pair.SessionID || db.sessions[0].AccountID != account.ID {
+		t.Fatalf("createSession: unexpected sessions %+v for tokens %+v", db.sessions, pair)
+	}
+	if events.logins != 1 {
+		t.Fatalf("createSession: expected 1 login event, got %d", events.logins)
+	}
+	if pair.Access == "" || pair.Refresh == "" {
+		t.Fatalf("createSession: expected access and refresh tokens, got %+v", pair)
+	}
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+// DeleteExpiredSessions deletes up to limit sessions past the idle timeout or the absolute
+// lifetime and publishes an expired event for each, the deletes and the events commit
+// together. It returns how many were deleted, a full batch means more may be waiting.
+func (s Service) DeleteExpiredSessions(ctx context.Context, limit uint64) (int, error) {
+	expiry := s.cfg.Sessions.Expiry
+	now := time.Now().UTC()
+
+	var lastUsedBefore, createdBefore *time.Time
+	if expiry.IdleTimeout > 0 {
+		t := now.Add(-expiry.IdleTimeout)
+		lastUsedBefore = &t
+	}
+	if expiry.AbsoluteLifetime > 0 {
+		t := now.Add(-expiry.AbsoluteLifetime)
+		createdBefore = &t
+	}
+	if lastUsedBefore == nil && createdBefore == nil {
+		return 0, nil
+	}
+
+	var deleted int
+	err := s.UnitOfWork(ctx, func(ctx context.Context) error {
+		sessions, err := s.db.DeleteExpiredSessions(ctx, lastUsedBefore, createdBefore, limit)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to delete expired sessions, cause: %w", err),
+			)
+		}
+
+		for _, session := range sessions {
+			account, err := s.db.GetAccountByID(ctx, session.AccountID)
+			if err != nil {
+				return errx.ErrorInternal.Raise(
+					fmt.Errorf("failed to get account %s of expired session %s, cause: %w", session.AccountID, session.ID, err),
+				)
+			}
+			if account.IsNil() {
+				continue
+			}
+
+			email, err := s.GetAccountEmail(ctx, account.ID)
+			if err != nil {
+				return err
+			}
+
+			err = s.event.WriteAccountSessionExpired(ctx, account, email.Email, session, session.ExpiredBy(expiry, now))
+			if err != nil {
+				return errx.ErrorInternal.Raise(
+					fmt.Errorf("failed to publish session expired event for session %s, cause: %w", session.ID, err),
+				)
+			}
+		}
+
+		deleted = len(sessions)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+// BeginSocialLogin starts a login with the provider. The returned state carries the
+// values for the authorization request, the state itself is stored only as a hash.
+func (s Service) BeginSocialLogin(ctx context.Context, provider, redirectURI string) (entity.SocialLoginState, error) {
+	return s.createSocialLoginState(ctx, entity.SocialLoginState{
+		Provider:    provider,
+		Purpose:     entity.SocialLoginPurposeLogin,
+		RedirectURI: redirectURI,
+	})
+}
+
+// BeginOwnIdentityLink starts linking a provider user to the account of the initiator,
+// the callback links whoever logs in at the provider.
+func (s Service) BeginOwnIdentityLink(
+	ctx context.Context,
+	initiator InitiatorData,
+	provider, redirectURI string,
+) (entity.SocialLoginState, error) {
+	_, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return entity.SocialLoginState{}, err
+	}
+
+	return s.createSocialLoginState(ctx, entity.SocialLoginState{
+		Provider:    provider,
+		Purpose:     entity.SocialLoginPurposeLink,
+		AccountID:   initiator.AccountID,
+		SessionID:   initiator.SessionID,
+		RedirectURI: redirectURI,
+	})
+}
+
+// ConsumeSocialLoginState returns the state the provider sent back to the callback. A
+// state is usable once, a replayed one is reported as invalid.
+func (s Service) ConsumeSocialLoginState(ctx context.Context, provider, state string) (entity.SocialLoginState, error) {
+	if state == "" {
+		return entity.SocialLoginState{}, errx.ErrorSocialStateInvalid.Raise(
+			fmt.Errorf("%s callback has no state", provider),
+		)
+	}
+
+	loginState, err := s.db.ConsumeSocialLoginState(ctx, hashSecretToken(state))
+	if err != nil {
+		return entity.SocialLoginState{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get %s login state, cause: %w", provider, err),
+		)
+	}
+
+	if err = loginState.CanBeConsumed(provider); err != nil {
+		return entity.SocialLoginState{}, err
+	}
+
+	return loginState, nil
+}
+
+func (s Service) createSocialLoginState(
+	ctx context.Context,
+	params entity.SocialLoginState,
+) (entity.SocialLoginState, error) {
+	if params.RedirectURI != "" && !slices.Contains(s.cfg.Social.RedirectURIs, params.RedirectURI) {
+		return entity.SocialLoginState{}, errx.ErrorSocialRedirectURINotAllowed.Raise(
+			fmt.Errorf("redirect uri %s is not allowed", params.RedirectURI),
+		)
+	}
+
+	var values [3]string
+	for i := range values {
+		value, err := generateSecretToken()
+		if err != nil {
+			return entity.SocialLoginState{}, errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to generate %s login state, cause: %w", params.Provider, err),
+			)
+		}
+
+		values[i] = value
+	}
+
+	params.StateHash = hashSecretToken(values[0])
+	params.CodeVerifier = values[1]
+	params.Nonce = values[2]
+	params.ExpiresAt = time.Now().UTC().Add(s.cfg.Social.StateTTL)
+
+	state, err := s.db.CreateSocialLoginState(ctx, params)
+	if err != nil {
+		return entity.SocialLoginState{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to save %s login state, cause: %w", params.Provider, err),
+		)
+	}
+
+	state.State = values[0]
+
+	return state, nil
+}
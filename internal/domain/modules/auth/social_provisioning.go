@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/umisto/restkit/roles"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+const (
+	maxUsernameLength       = 32
+	usernameSuffixLength    = 6
+	usernameSuffixAttempts  = 10
+	provisionedUsernameBase = "user"
+)
+
+// provisionSocialAccount creates the account of a provider user on the first login. The
+// email must have been verified by the provider. The account has no password, the user
+// can set one through the password reset.
+func (s Service) provisionSocialAccount(ctx context.Context, identity entity.SocialIdentity) (entity.Account, error) {
+	username, err := s.generateUsername(ctx, identity)
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	var account entity.Account
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		account, err = s.db.CreateAccount(ctx, CreateAccountParams{
+			Username:      username,
+			Role:          roles.SystemUser,
+			Email:         identity.Email,
+			EmailVerified: true,
+			Identity:      &identity,
+		})
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to provision account for %s identity %s, cause: %w",
+					identity.Provider, identity.Subject, err),
+			)
+		}
+
+		err = s.event.WriteAccountCreated(ctx, account, identity.Email, identity.Provider)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to publish account created event for account '%s', cause: %w", account.ID, err),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	return account, nil
+}
+
+// generateUsername derives a free username from the provider profile, falling back to a
+// random suffix when the derived one is taken.
+func (s Service) generateUsername(ctx context.Context, identity entity.SocialIdentity) (string, error) {
+	base := provisionedUsernameBase
+	for _, candidate := range []string{identity.Username, strings.Split(identity.Email, "@")[0], identity.Name} {
+		candidate = sanitizeUsername(candidate, maxUsernameLength)
+		if s.CheckUsernameRequirements(candidate) == nil {
+			base = candidate
+			break
+		}
+	}
+
+	taken, err := s.AccountExistsByUsername(ctx, base)
+	if err != nil {
+		return "", err
+	}
+	if !taken {
+		return base, nil
+	}
+
+	prefix := sanitizeUsername(base, maxUsernameLength-usernameSuffixLength-1)
+	for i := 0; i < usernameSuffixAttempts; i++ {
+		buf := make([]byte, usernameSuffixLength/2)
+		if _, err = rand.Read(buf); err != nil {
+			return "", errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to generate username suffix, cause: %w", err),
+			)
+		}
+
+		username := prefix + "-" + hex.EncodeToString(buf)
+		if err = s.CheckUsernameRequirements(username); err != nil {
+			return "", errx.ErrorInternal.Raise(
+				fmt.Errorf("generated username '%s' is not allowed, cause: %w", username, err),
+			)
+		}
+
+		taken, err = s.AccountExistsByUsername(ctx, username)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return username, nil
+		}
+	}
+
+	return "", errx.ErrorInternal.Raise(
+		fmt.Errorf("failed to find a free username for %s identity %s", identity.Provider, identity.Subject),
+	)
+}
+
+// sanitizeUsername replaces the characters not allowed in a username and cuts it to
+// maxBytes, the limit CheckUsernameRequirements applies.
+func sanitizeUsername(value string, maxBytes int) string {
+	var b strings.Builder
+
+	for _, r := range strings.TrimSpace(value) {
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-') {
+			r = '_'
+		}
+		if b.Len()+len(string(r)) > maxBytes {
+			break
+		}
+
+		b.WriteRune(r)
+	}
+
+	return strings.Trim(b.String(), "_-")
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+func (s Service) RequestEmailChange(
+	ctx context.Context,
+	initiator InitiatorData,
+	password string,
+	newEmail string,
+) error {
+	account, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return err
+	}
+
+	emailData, err := s.GetAccountEmail(ctx, initiator.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if err = emailData.CanChangeEmail(); err != nil {
+		return err
+	}
+
+	if err = s.checkAccountPassword(ctx, initiator.AccountID, password); err != nil {
+		return err
+	}
+
+	check, err := s.AccountExistsByEmail(ctx, newEmail)
+	if err != nil {
+		return err
+	}
+	if check {
+		return errx.ErrorEmailAlreadyExist.Raise(
+			fmt.Errorf("account with email '%s' already exists", newEmail),
+		)
+	}
+
+	code, expiresAt, err := s.jwt.GenerateEmailChange(account.ID, newEmail)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to generate email change code for account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	return s.UnitOfWork(ctx, func(ctx context.Context) error {
+		_, err = s.db.CreateAccountEmailChange(ctx, account.ID, newEmail, expiresAt)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to create email change for account %s, cause: %w", account.ID, err),
+			)
+		}
+
+		err = s.event.WriteAccountEmailVerificationRequested(ctx, account, newEmail, code, expiresAt)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to publish email verification requested event for account %s, cause: %w", account.ID, err),
+			)
+		}
+
+		return nil
+	})
+}
+
+func (s Service) ConfirmEmailChange(ctx context.Context, initiator InitiatorData, code string) (entity.AccountEmail, error) {
+	account, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return entity.AccountEmail{}, err
+	}
+
+	change, err := s.db.GetAccountEmailChange(ctx, initiator.AccountID)
+	if err != nil {
+		return entity.AccountEmail{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get email change for account %s, cause: %w", initiator.AccountID, err),
+		)
+	}
+	if change.IsNil() {
+		return entity.AccountEmail{}, errx.ErrorEmailChangeNotFound.Raise(
+			fmt.Errorf("no pending email change for account %s", initiator.AccountID),
+		)
+	}
+
+	if err = change.CanBeConfirmed(); err != nil {
+		return entity.AccountEmail{}, err
+	}
+
+	accountID, email, err := s.jwt.ParseEmailChange(code)
+	if err != nil {
+		return entity.AccountEmail{}, errx.ErrorEmailVerificationCodeInvalid.Raise(
+			fmt.Errorf("failed to parse email change code for account %s, cause: %w", initiator.AccountID, err),
+		)
+	}
+	if accountID != initiator.AccountID || email != change.NewEmail {
+		return entity.AccountEmail{}, errx.ErrorEmailVerificationCodeInvalid.Raise(
+			fmt.Errorf("email change code was not issued for pending email of account %s", initiator.AccountID),
+		)
+	}
+
+	check, err := s.AccountExistsByEmail(ctx, change.NewEmail)
+	if err != nil {
+		return entity.AccountEmail{}, err
+	}
+	if check {
+		return entity.AccountEmail{}, errx.ErrorEmailAlreadyExist.Raise(
+			fmt.Errorf("account with email '%s' already exists", change.NewEmail),
+		)
+	}
+
+	oldEmail, err := s.GetAccountEmail(ctx, initiator.AccountID)
+	if err != nil {
+		return entity.AccountEmail{}, err
+	}
+
+	var emailData entity.AccountEmail
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		emailData, err = s.db.ConfirmAccountEmailChange(ctx, initiator.AccountID, change.NewEmail)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("confirming email change for account %s, cause: %w", initiator.AccountID, err),
+			)
+		}
+
+		err = s.event.WriteAccountEmailChanged(ctx, account, oldEmail.Email, emailData.Email)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to publish email changed event for account %s, cause: %w", account.ID, err),
+			)
+		}
+
+		return s.writeAudit(ctx, entity.AuditActionEmailChanged, initiator.AccountID, initiator.AccountID, map[string]any{
+			"old_email": oldEmail.Email,
+			"new_email": emailData.Email,
+		})
+	})
+	if err != nil {
+		return entity.AccountEmail{}, err
+	}
+
+	return emailData, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/umisto/sso-svc/internal/domain/entity"
 	"github.com/umisto/sso-svc/internal/domain/errx"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -49,20 +50,20 @@ func (s Service) UpdatePassword(
 		)
 	}
 
-	_, err = s.db.UpdateAccountPassword(ctx, initiator.AccountID, string(hash))
-	if err != nil {
-		return err
-	}
-
 	email, err := s.GetAccountEmail(ctx, account.ID)
 	if err != nil {
 		return err
 	}
 
-	err = s.event.WriteAccountPasswordChanged(ctx, account, email.Email)
-	if err != nil {
-		return err
-	}
+	return s.UnitOfWork(ctx, func(ctx context.Context) error {
+		if _, err = s.db.UpdateAccountPassword(ctx, initiator.AccountID, string(hash)); err != nil {
+			return err
+		}
+
+		if err = s.writeAudit(ctx, entity.AuditActionPasswordChanged, initiator.AccountID, initiator.AccountID, nil); err != nil {
+			return err
+		}
 
-	return nil
+		return s.event.WriteAccountPasswordChanged(ctx, account, email.Email)
+	})
 }
@@ -31,21 +31,32 @@ func (s Service) UpdateUsername(
 		return entity.Account{}, err
 	}
 
-	account, err = s.db.UpdateAccountUsername(ctx, initiator.AccountID, newUsername)
-	if err != nil {
-		return entity.Account{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("updating username for account %s, cause: %w", initiator.AccountID, err),
-		)
-	}
-
 	email, err := s.GetAccountEmail(ctx, account.ID)
 	if err != nil {
 		return entity.Account{}, err
 	}
 
-	err = s.event.WriteAccountUsernameChanged(ctx, account, email.Email)
+	oldUsername := account.Username
+	err = s.UnitOfWork(ctx, func(ctx context.Context) error {
+		account, err = s.db.UpdateAccountUsername(ctx, initiator.AccountID, newUsername)
+		if err != nil {
+			return err
+		}
+
+		err = s.writeAudit(ctx, entity.AuditActionUsernameChanged, initiator.AccountID, initiator.AccountID, map[string]any{
+			"old_username": oldUsername,
+			"new_username": newUsername,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.event.WriteAccountUsernameChanged(ctx, account, email.Email)
+	})
 	if err != nil {
-		return entity.Account{}, err
+		return entity.Account{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("updating username for account %s, cause: %w", initiator.AccountID, err),
+		)
 	}
 
 	return account, nil
@@ -3,24 +3,90 @@ package auth
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/umisto/sso-svc/internal/domain/entity"
 	"github.com/umisto/sso-svc/internal/domain/errx"
 )
 
-// VerifyEmail is a callback function to verify email addresses, which we should use in Kafka consumer
-func (s Service) VerifyEmail(ctx context.Context, email string) (entity.AccountEmail, error) {
-	account, err := s.GetAccountByEmail(ctx, email)
+func (s Service) VerifyEmail(ctx context.Context, initiator InitiatorData, code string) (entity.AccountEmail, error) {
+	_, _, err := s.ValidateSession(ctx, initiator)
 	if err != nil {
 		return entity.AccountEmail{}, err
 	}
 
-	emailData, err := s.db.UpdateAccountEmailVerification(ctx, account.ID, true)
+	emailData, err := s.GetAccountEmail(ctx, initiator.AccountID)
+	if err != nil {
+		return entity.AccountEmail{}, err
+	}
+
+	accountID, email, err := s.jwt.ParseEmailVerification(code)
+	if err != nil {
+		return entity.AccountEmail{}, errx.ErrorEmailVerificationCodeInvalid.Raise(
+			fmt.Errorf("failed to parse email verification code for account %s, cause: %w", initiator.AccountID, err),
+		)
+	}
+	if accountID != initiator.AccountID || email != emailData.Email {
+		return entity.AccountEmail{}, errx.ErrorEmailVerificationCodeInvalid.Raise(
+			fmt.Errorf("email verification code was not issued for current email of account %s", initiator.AccountID),
+		)
+	}
+
+	if emailData.Verified {
+		return emailData, nil
+	}
+
+	emailData, err = s.db.UpdateAccountEmailVerification(ctx, initiator.AccountID, true)
 	if err != nil {
 		return entity.AccountEmail{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("verifying email for account %s, cause: %w", account.ID, err),
+			fmt.Errorf("verifying email for account %s, cause: %w", initiator.AccountID, err),
 		)
 	}
 
 	return emailData, nil
 }
+
+func (s Service) ResendEmailVerification(ctx context.Context, initiator InitiatorData) error {
+	account, _, err := s.ValidateSession(ctx, initiator)
+	if err != nil {
+		return err
+	}
+
+	emailData, err := s.GetAccountEmail(ctx, initiator.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if err = emailData.CanResendVerification(); err != nil {
+		return err
+	}
+
+	return s.sendEmailVerification(ctx, account, emailData.Email)
+}
+
+func (s Service) sendEmailVerification(ctx context.Context, account entity.Account, email string) error {
+	code, expiresAt, err := s.jwt.GenerateEmailVerification(account.ID, email)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to generate email verification code for account %s, cause: %w", account.ID, err),
+		)
+	}
+
+	return s.UnitOfWork(ctx, func(ctx context.Context) error {
+		_, err = s.db.UpdateAccountEmailVerificationSentAt(ctx, account.ID, time.Now().UTC())
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to save email verification send time for account %s, cause: %w", account.ID, err),
+			)
+		}
+
+		err = s.event.WriteAccountEmailVerificationRequested(ctx, account, email, code, expiresAt)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to publish email verification requested event for account %s, cause: %w", account.ID, err),
+			)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,76 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/events/contracts"
+)
+
+type domain interface {
+	ConfirmEmailVerified(ctx context.Context, accountID uuid.UUID, email string) error
+	SuspendAccountByAdmin(ctx context.Context, initiatorID, accountID uuid.UUID, reason string) error
+	DeleteAccountByRequest(ctx context.Context, initiatorID, accountID uuid.UUID, reason string) error
+}
+
+func accountEmailVerified(domain domain) handler {
+	return func(ctx context.Context, event InboxEvent) error {
+		var payload contracts.AccountEmailVerifiedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return permanent(fmt.Errorf("decoding %s payload: %w", event.Type, err))
+		}
+
+		return domainErr(domain.ConfirmEmailVerified(ctx, payload.AccountID, payload.Email))
+	}
+}
+
+func accountBlockRequested(domain domain) handler {
+	return func(ctx context.Context, event InboxEvent) error {
+		var payload contracts.AccountBlockRequestedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return permanent(fmt.Errorf("decoding %s payload: %w", event.Type, err))
+		}
+
+		return domainErr(domain.SuspendAccountByAdmin(ctx, payload.InitiatorID, payload.AccountID, payload.Reason))
+	}
+}
+
+func accountDeletionRequested(domain domain) handler {
+	return func(ctx context.Context, event InboxEvent) error {
+		var payload contracts.AccountDeletionRequestedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return permanent(fmt.Errorf("decoding %s payload: %w", event.Type, err))
+		}
+
+		return domainErr(domain.DeleteAccountByRequest(ctx, payload.InitiatorID, payload.AccountID, payload.Reason))
+	}
+}
+
+// permanentError is a failure a retry cannot fix, the event is marked failed at once.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+func permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// domainErr sorts the errors of the domain. An account already gone leaves nothing to
+// do, a request the domain refuses is refused again on a retry.
+func domainErr(err error) error {
+	switch {
+	case err == nil, errors.Is(err, errx.ErrorAccountNotFound):
+		return nil
+	case errors.Is(err, errx.ErrorInternal):
+		return err
+	default:
+		return permanent(err)
+	}
+}
@@ -0,0 +1,35 @@
+package consumer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	InboxStatusPending    = "pending"
+	InboxStatusProcessing = "processing"
+	InboxStatusProcessed  = "processed"
+	InboxStatusFailed     = "failed"
+)
+
+// InboxEvent is a received message kept until its handler succeeds. The id comes from
+// the event id header, a message delivered twice is stored once.
+type InboxEvent struct {
+	ID          uuid.UUID
+	Topic       string
+	Key         string
+	Type        string
+	Version     int32
+	Producer    string
+	Payload     []byte
+	Status      string
+	Attempts    int32
+	CreatedAt   time.Time
+	NextRetryAt *time.Time
+	ProcessedAt *time.Time
+}
+
+func (e InboxEvent) IsNil() bool {
+	return e.ID == uuid.Nil
+}
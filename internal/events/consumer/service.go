@@ -0,0 +1,265 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/umisto/kafkakit/header"
+	"github.com/umisto/logium"
+	"github.com/umisto/sso-svc/internal/events/contracts"
+)
+
+type Config struct {
+	Brokers []string
+	GroupID string
+	Topics  []string
+	// AllowedProducers lists the services whose events are handled, the events of any
+	// other producer are marked failed unhandled. The producer header is set by the
+	// sender, so it only holds when the ACLs of the topics let no one else write to them.
+	AllowedProducers []string
+	// MaxAttempts is how often a handler is tried before the event is marked failed.
+	MaxAttempts    int32
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+}
+
+type Service struct {
+	log      logium.Logger
+	cfg      Config
+	inbox    inbox
+	handlers map[string]handler
+}
+
+type handler func(ctx context.Context, event InboxEvent) error
+
+type inbox interface {
+	CreateInboxEvent(ctx context.Context, event InboxEvent) error
+	GetPendingInboxEvents(ctx context.Context, limit int32) ([]InboxEvent, error)
+	ClaimInboxEvent(ctx context.Context, id uuid.UUID) (InboxEvent, error)
+	MarkInboxEventProcessed(ctx context.Context, id uuid.UUID) error
+	MarkInboxEventAsPending(ctx context.Context, id uuid.UUID, delay time.Duration) error
+	MarkInboxEventAsFailed(ctx context.Context, id uuid.UUID) error
+
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+func New(log logium.Logger, cfg Config, inbox inbox, domain domain) *Service {
+	s := &Service{
+		log:   log,
+		cfg:   cfg,
+		inbox: inbox,
+	}
+
+	s.handlers = map[string]handler{
+		contracts.AccountEmailVerifiedEvent:     accountEmailVerified(domain),
+		contracts.AccountBlockRequestedEvent:    accountBlockRequested(domain),
+		contracts.AccountDeletionRequestedEvent: accountDeletionRequested(domain),
+	}
+
+	return s
+}
+
+const inboxBatchSize = 100
+
+// Run reads the configured topics into the inbox and processes the inbox until ctx is
+// done. A message is committed to kafka once it is stored, handlers run from the inbox.
+func (s *Service) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		s.receive(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		s.process(ctx)
+	}()
+
+	wg.Wait()
+}
+
+func (s *Service) receive(ctx context.Context) {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     s.cfg.Brokers,
+		GroupID:     s.cfg.GroupID,
+		GroupTopics: s.cfg.Topics,
+	})
+	defer func() {
+		if err := reader.Close(); err != nil {
+			s.log.Errorf("inbox: close reader: %v", err)
+		}
+	}()
+
+	for {
+		msg, err := reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			s.log.Errorf("inbox: fetch message: %v", err)
+			continue
+		}
+
+		// committing a later offset of the partition would commit this message as well, so
+		// no message is fetched before this one is stored
+		if !s.store(ctx, msg) {
+			return
+		}
+
+		if err = reader.CommitMessages(ctx, msg); err != nil {
+			s.log.Errorf("inbox: commit message %s/%d/%d: %v", msg.Topic, msg.Partition, msg.Offset, err)
+		}
+	}
+}
+
+// store puts the message into the inbox, retrying with a growing delay until it is stored.
+// It reports false when ctx is done first.
+func (s *Service) store(ctx context.Context, msg kafka.Message) bool {
+	event := inboxEvent(msg)
+
+	for attempt := int32(1); ; attempt++ {
+		err := s.inbox.CreateInboxEvent(ctx, event)
+		if err == nil {
+			return true
+		}
+
+		delay := retryDelay(attempt, s.cfg.RetryBaseDelay, s.cfg.RetryMaxDelay)
+		s.log.Errorf("inbox: store message %s/%d/%d, attempt %d, retry in %s: %v",
+			msg.Topic, msg.Partition, msg.Offset, attempt, delay, err)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (s *Service) process(ctx context.Context) {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			events, err := s.inbox.GetPendingInboxEvents(ctx, inboxBatchSize)
+			if err != nil {
+				s.log.Errorf("inbox.GetPendingInboxEvents: %v", err)
+				continue
+			}
+
+			for _, event := range events {
+				s.handle(ctx, event.ID)
+			}
+		}
+	}
+}
+
+// handle runs the handler of the event in one transaction with marking it processed, so
+// the changes of a handler are made once even when the event is delivered again. Events
+// of producers not allowed are never handled.
+func (s *Service) handle(ctx context.Context, id uuid.UUID) {
+	var event InboxEvent
+	err := s.inbox.Transaction(ctx, func(ctx context.Context) (err error) {
+		event, err = s.inbox.ClaimInboxEvent(ctx, id)
+		if err != nil || event.IsNil() {
+			return err
+		}
+
+		if !slices.Contains(s.cfg.AllowedProducers, event.Producer) {
+			return permanent(fmt.Errorf("producer %q is not allowed", event.Producer))
+		}
+
+		h, ok := s.handlers[event.Type]
+		if !ok {
+			s.log.Debugf("inbox: no handler for event %s of type %s, skipped", event.ID, event.Type)
+		} else if err = h(ctx, event); err != nil {
+			return err
+		}
+
+		return s.inbox.MarkInboxEventProcessed(ctx, event.ID)
+	})
+	switch {
+	case err == nil:
+		return
+	case event.IsNil():
+		s.log.Errorf("inbox: claim event %s: %v", id, err)
+		return
+	}
+
+	attempt := event.Attempts + 1
+	if attempt >= s.cfg.MaxAttempts || errors.As(err, new(permanentError)) {
+		s.log.Errorf("inbox: event %s of type %s failed after %d attempts: %v", event.ID, event.Type, attempt, err)
+		if err = s.inbox.MarkInboxEventAsFailed(ctx, event.ID); err != nil {
+			s.log.Errorf("inbox: mark event %s as failed: %v", event.ID, err)
+		}
+		return
+	}
+
+	delay := retryDelay(attempt, s.cfg.RetryBaseDelay, s.cfg.RetryMaxDelay)
+	s.log.Warnf("inbox: event %s of type %s failed, attempt %d, retry in %s: %v", event.ID, event.Type, attempt, delay, err)
+	if err = s.inbox.MarkInboxEventAsPending(ctx, event.ID, delay); err != nil {
+		s.log.Errorf("inbox: delay event %s: %v", event.ID, err)
+	}
+}
+
+// retryDelay doubles the base delay with every attempt, up to max.
+func retryDelay(attempt int32, base, max time.Duration) time.Duration {
+	delay := base
+	for i := int32(1); i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		return max
+	}
+
+	return delay
+}
+
+var inboxNamespace = uuid.MustParse("3a2b0c5e-5f5d-4d0e-9a57-0f4f3c1f7b1a")
+
+// inboxEvent reads the message headers. A message without a valid event id gets one
+// derived from its position in the topic, which is stable across deliveries.
+func inboxEvent(msg kafka.Message) InboxEvent {
+	event := InboxEvent{
+		Topic:     msg.Topic,
+		Key:       string(msg.Key),
+		Payload:   msg.Value,
+		Status:    InboxStatusPending,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	for _, h := range msg.Headers {
+		switch h.Key {
+		case header.EventID:
+			if id, err := uuid.ParseBytes(h.Value); err == nil {
+				event.ID = id
+			}
+		case header.EventType:
+			event.Type = string(h.Value)
+		case header.EventVersion:
+			if version, err := strconv.ParseInt(string(h.Value), 10, 32); err == nil {
+				event.Version = int32(version)
+			}
+		case header.Producer:
+			event.Producer = string(h.Value)
+		}
+	}
+
+	if event.ID == uuid.Nil {
+		event.ID = uuid.NewSHA1(inboxNamespace, []byte(fmt.Sprintf("%s/%d/%d", msg.Topic, msg.Partition, msg.Offset)))
+	}
+
+	return event
+}
@@ -0,0 +1,159 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/umisto/kafkakit/header"
+	"github.com/umisto/logium"
+)
+
+func TestRetryDelay(t *testing.T) {
+	for _, tc := range []struct {
+		attempt int32
+		want    time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{4, 8 * time.Second},
+		{10, time.Minute},
+	} {
+		if got := retryDelay(tc.attempt, time.Second, time.Minute); got != tc.want {
+			t.Fatalf("retryDelay(%d) = %s, want %s", tc.attempt, got, tc.want)
+		}
+	}
+}
+
+func TestInboxEvent(t *testing.T) {
+	id := uuid.New()
+	msg := kafka.Message{
+		Topic:     "accounts.commands.v1",
+		Partition: 1,
+		Offset:    42,
+		Key:       []byte("key"),
+		Value:     []byte(`{}`),
+		Headers: []kafka.Header{
+			{Key: header.EventID, Value: []byte(id.String())},
+			{Key: header.EventType, Value: []byte("account.email.verified")},
+			{Key: header.EventVersion, Value: []byte("2")},
+			{Key: header.Producer, Value: []byte("notifications")},
+		},
+	}
+
+	event := inboxEvent(msg)
+	if event.ID != id || event.Type != "account.email.verified" || event.Version != 2 || event.Producer != "notifications" {
+		t.Fatalf("inboxEvent = %+v, want the values of the headers", event)
+	}
+	if event.Status != InboxStatusPending {
+		t.Fatalf("status = %s, want %s", event.Status, InboxStatusPending)
+	}
+
+	msg.Headers = msg.Headers[1:]
+	first, second := inboxEvent(msg), inboxEvent(msg)
+	if first.ID == uuid.Nil || first.ID != second.ID {
+		t.Fatalf("ids without the event id header = %s and %s, want one stable id", first.ID, second.ID)
+	}
+
+	msg.Offset++
+	if inboxEvent(msg).ID == first.ID {
+		t.Fatal("messages at different offsets got the same id")
+	}
+}
+
+// testInbox holds one event and records what became of it.
+type testInbox struct {
+	inbox
+
+	event  InboxEvent
+	status string
+
+	// failStores is how many stores fail before one succeeds
+	failStores int
+	stored     []InboxEvent
+}
+
+func (i *testInbox) CreateInboxEvent(_ context.Context, event InboxEvent) error {
+	if i.failStores > 0 {
+		i.failStores--
+		return errors.New("database is down")
+	}
+
+	i.stored = append(i.stored, event)
+	return nil
+}
+
+func (i *testInbox) ClaimInboxEvent(context.Context, uuid.UUID) (InboxEvent, error) {
+	return i.event, nil
+}
+
+func (i *testInbox) MarkInboxEventProcessed(context.Context, uuid.UUID) error {
+	i.status = InboxStatusProcessed
+	return nil
+}
+
+func (i *testInbox) MarkInboxEventAsFailed(context.Context, uuid.UUID) error {
+	i.status = InboxStatusFailed
+	return nil
+}
+
+func (i *testInbox) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestHandleChecksProducer(t *testing.T) {
+	for _, tc := range []struct {
+		producer string
+		want     string
+	}{
+		{"notifications", InboxStatusProcessed},
+		{"", InboxStatusFailed},
+		{"intruder", InboxStatusFailed},
+	} {
+		handled := false
+		inbox := &testInbox{event: InboxEvent{ID: uuid.New(), Type: "test", Producer: tc.producer}}
+
+		s := New(logium.NewLogger("error", "text"), Config{
+			AllowedProducers: []string{"notifications"},
+			MaxAttempts:      10,
+		}, inbox, nil)
+		s.handlers["test"] = func(context.Context, InboxEvent) error {
+			handled = true
+			return nil
+		}
+
+		s.handle(context.Background(), inbox.event.ID)
+
+		if inbox.status != tc.want || handled != (tc.want == InboxStatusProcessed) {
+			t.Fatalf("producer %q: status = %s, handled = %t, want %s", tc.producer, inbox.status, handled, tc.want)
+		}
+	}
+}
+
+func TestStoreRetriesUntilStored(t *testing.T) {
+	msg := kafka.Message{Topic: "accounts.commands.v1", Partition: 1, Offset: 42}
+	cfg := Config{RetryBaseDelay: time.Millisecond, RetryMaxDelay: time.Millisecond}
+
+	inbox := &testInbox{failStores: 3}
+	s := New(logium.NewLogger("error", "text"), cfg, inbox, nil)
+
+	if !s.store(context.Background(), msg) {
+		t.Fatal("store: expected the message to be stored")
+	}
+	if inbox.failStores != 0 || len(inbox.stored) != 1 {
+		t.Fatalf("store: %d failures left, %d stored, want every retry used and one stored", inbox.failStores, len(inbox.stored))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	inbox = &testInbox{failStores: 100}
+	s = New(logium.NewLogger("error", "text"), cfg, inbox, nil)
+
+	if s.store(ctx, msg) || len(inbox.stored) != 0 {
+		t.Fatal("store: expected to give up once the context is done")
+	}
+}
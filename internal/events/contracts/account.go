@@ -0,0 +1,21 @@
+package contracts
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Account is the account as the events carry it. It is kept apart from the domain
+// entity, a change of the entity does not change the events until it is made here.
+type Account struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	Role     string    `json:"role"`
+	Status   string    `json:"status"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// UsernameUpdatedAt keeps the name the first version of the events was published with.
+	UsernameUpdatedAt time.Time `json:"username_name_updated_at"`
+}
@@ -0,0 +1,31 @@
+package contracts
+
+import (
+	"github.com/google/uuid"
+)
+
+// AccountEmailVerifiedEvent reports an email confirmed outside sso-svc, by the service
+// that delivered the verification code.
+const AccountEmailVerifiedEvent = "account.email.verified"
+
+type AccountEmailVerifiedPayload struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Email     string    `json:"email"`
+}
+
+const AccountBlockRequestedEvent = "account.block.requested"
+
+type AccountBlockRequestedPayload struct {
+	AccountID   uuid.UUID `json:"account_id"`
+	InitiatorID uuid.UUID `json:"initiator_id"`
+	Reason      string    `json:"reason"`
+}
+
+const AccountDeletionRequestedEvent = "account.deletion.requested"
+
+type AccountDeletionRequestedPayload struct {
+	AccountID uuid.UUID `json:"account_id"`
+	// InitiatorID is the admin who requested the deletion, nil when the user did.
+	InitiatorID uuid.UUID `json:"initiator_id,omitempty"`
+	Reason      string    `json:"reason,omitempty"`
+}
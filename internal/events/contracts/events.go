@@ -1,10 +1,17 @@
 package contracts
 
-import "github.com/umisto/sso-svc/internal/domain/entity"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type AccountCreatedPayload struct {
-	Account entity.Account `json:"account"`
-	Email   string         `json:"email,omitempty"`
+	Account Account `json:"account"`
+	Email   string  `json:"email,omitempty"`
+	// Source is how the account was created, registration, admin or the name of the
+	// login provider for an account provisioned on the first social login.
+	Source string `json:"source,omitempty"`
 }
 
 const AccountCreatedEvent = "account.created"
@@ -12,20 +19,100 @@ const AccountCreatedEvent = "account.created"
 const AccountLoginEvent = "account.login"
 
 type AccountLoginPayload struct {
-	Account entity.Account `json:"account"`
-	Email   string         `json:"email"`
+	Account   Account   `json:"account"`
+	Email     string    `json:"email"`
+	SessionID uuid.UUID `json:"session_id"`
+	// EvictedSessionIDs lists the sessions ended to keep the account under the session limit.
+	EvictedSessionIDs []uuid.UUID `json:"evicted_session_ids,omitempty"`
 }
 
 const AccountPasswordChangeEvent = "account.password.change"
 
 type AccountPasswordChangePayload struct {
-	Account entity.Account `json:"account"`
-	Email   string         `json:"email"`
+	Account Account `json:"account"`
+	Email   string  `json:"email"`
 }
 
 const AccountUsernameChangeEvent = "account.username.change"
 
 type AccountUsernameChangePayload struct {
-	Account entity.Account `json:"account"`
-	Email   string         `json:"email"`
+	Account Account `json:"account"`
+	Email   string  `json:"email"`
+}
+
+const AccountPasswordResetRequestedEvent = "account.password.reset_requested"
+
+type AccountPasswordResetRequestedPayload struct {
+	Account   Account   `json:"account"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+const AccountEmailVerificationRequestedEvent = "account.email.verification_requested"
+
+type AccountEmailVerificationRequestedPayload struct {
+	Account   Account   `json:"account"`
+	Email     string    `json:"email"`
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+const AccountEmailChangeEvent = "account.email.change"
+
+type AccountEmailChangePayload struct {
+	Account  Account `json:"account"`
+	Email    string  `json:"email"`
+	OldEmail string  `json:"old_email"`
+}
+
+const AccountLoginFailedEvent = "account.login.failed"
+
+type AccountLoginFailedPayload struct {
+	Account        Account    `json:"account"`
+	Email          string     `json:"email"`
+	IP             string     `json:"ip,omitempty"`
+	FailedAttempts int32      `json:"failed_attempts"`
+	LockedUntil    *time.Time `json:"locked_until,omitempty"`
+}
+
+const AccountSessionCompromisedEvent = "account.session.compromised"
+
+type AccountSessionCompromisedPayload struct {
+	Account            Account   `json:"account"`
+	Email              string    `json:"email"`
+	SessionID          uuid.UUID `json:"session_id"`
+	AllSessionsRevoked bool      `json:"all_sessions_revoked"`
+}
+
+const AccountSessionExpiredEvent = "account.session.expired"
+
+type AccountSessionExpiredPayload struct {
+	Account   Account   `json:"account"`
+	Email     string    `json:"email"`
+	SessionID uuid.UUID `json:"session_id"`
+	// Reason is the limit the session reached, idle or absolute.
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+	LastUsed  time.Time `json:"last_used"`
+}
+
+const AccountStatusChangeEvent = "account.status.change"
+
+type AccountStatusChangePayload struct {
+	Account     Account   `json:"account"`
+	Email       string    `json:"email"`
+	OldStatus   string    `json:"old_status"`
+	Reason      string    `json:"reason"`
+	InitiatorID uuid.UUID `json:"initiator_id"`
+}
+
+const AccountRoleChangeEvent = "account.role.change"
+
+type AccountRoleChangePayload struct {
+	Account     Account   `json:"account"`
+	Email       string    `json:"email"`
+	OldRole     string    `json:"old_role"`
+	Reason      string    `json:"reason"`
+	InitiatorID uuid.UUID `json:"initiator_id"`
 }
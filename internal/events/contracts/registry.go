@@ -0,0 +1,62 @@
+package contracts
+
+import (
+	"fmt"
+)
+
+// Event describes an event type on the wire: the version of its payload, sent in the
+// event version header, and the topic it goes to.
+type Event struct {
+	Type    string
+	Version int32
+	Topic   string
+	// Payload is a zero payload of the version, its type describes the schema.
+	Payload any
+}
+
+// SchemaFile is the name of the JSON Schema of the event version in the schemas directory.
+func (e Event) SchemaFile() string {
+	return fmt.Sprintf("%s.v%d.json", e.Type, e.Version)
+}
+
+// events lists the current version of every event sso-svc publishes or consumes. A change
+// a consumer cannot read, such as a removed or retyped field, needs a new payload type and
+// a higher version here, the schema of the old version stays in the schemas directory.
+var events = []Event{
+	{AccountCreatedEvent, 1, AccountsTopicV1, AccountCreatedPayload{}},
+	{AccountLoginEvent, 1, AccountsTopicV1, AccountLoginPayload{}},
+	{AccountLoginFailedEvent, 1, AccountsTopicV1, AccountLoginFailedPayload{}},
+	{AccountPasswordChangeEvent, 1, AccountsTopicV1, AccountPasswordChangePayload{}},
+	{AccountPasswordResetRequestedEvent, 1, AccountsTopicV1, AccountPasswordResetRequestedPayload{}},
+	{AccountUsernameChangeEvent, 1, AccountsTopicV1, AccountUsernameChangePayload{}},
+	{AccountEmailChangeEvent, 1, AccountsTopicV1, AccountEmailChangePayload{}},
+	{AccountEmailVerificationRequestedEvent, 1, AccountsTopicV1, AccountEmailVerificationRequestedPayload{}},
+	{AccountSessionCompromisedEvent, 1, AccountsTopicV1, AccountSessionCompromisedPayload{}},
+	{AccountSessionExpiredEvent, 1, AccountsTopicV1, AccountSessionExpiredPayload{}},
+	{AccountStatusChangeEvent, 1, AccountsTopicV1, AccountStatusChangePayload{}},
+	{AccountRoleChangeEvent, 1, AccountsTopicV1, AccountRoleChangePayload{}},
+
+	{AccountEmailVerifiedEvent, 1, AccountsCommandsTopicV1, AccountEmailVerifiedPayload{}},
+	{AccountBlockRequestedEvent, 1, AccountsCommandsTopicV1, AccountBlockRequestedPayload{}},
+	{AccountDeletionRequestedEvent, 1, AccountsCommandsTopicV1, AccountDeletionRequestedPayload{}},
+}
+
+var eventsByType = func() map[string]Event {
+	res := make(map[string]Event, len(events))
+	for _, e := range events {
+		res[e.Type] = e
+	}
+
+	return res
+}()
+
+// EventByType returns the current version of the event type, false when it is not known.
+func EventByType(eventType string) (Event, bool) {
+	e, ok := eventsByType[eventType]
+	return e, ok
+}
+
+// Events returns the current version of every event.
+func Events() []Event {
+	return append([]Event(nil), events...)
+}
@@ -0,0 +1,255 @@
+package contracts
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "write the schemas of new events and of compatible payload changes")
+
+// TestSchemas checks the payloads against the schemas in the schemas directory. A change
+// consumers can still read, like a new field, is written with -update, any other change
+// needs a new version of the event.
+func TestSchemas(t *testing.T) {
+	if len(eventsByType) != len(events) {
+		t.Fatalf("event types are registered more than once")
+	}
+
+	for _, e := range Events() {
+		t.Run(e.SchemaFile(), func(t *testing.T) {
+			want, err := json.MarshalIndent(Schema(e), "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			want = append(want, '\n')
+
+			path := filepath.Join("schemas", e.SchemaFile())
+
+			got, err := os.ReadFile(path)
+			switch {
+			case errors.Is(err, fs.ErrNotExist):
+				if !*update {
+					t.Fatalf("schema %s is missing, write it with make generate-event-schemas", path)
+				}
+			case err != nil:
+				t.Fatal(err)
+			case bytes.Equal(got, want):
+				return
+			default:
+				var old, cur map[string]any
+				if err = json.Unmarshal(got, &old); err != nil {
+					t.Fatalf("reading schema %s: %v", path, err)
+				}
+				if err = json.Unmarshal(want, &cur); err != nil {
+					t.Fatal(err)
+				}
+
+				if problems := incompatible("", old, cur); len(problems) > 0 {
+					t.Fatalf("payload of %s changed incompatibly with version %d, register version %d instead:\n%s",
+						e.Type, e.Version, e.Version+1, strings.Join(problems, "\n"))
+				}
+				if !*update {
+					t.Fatalf("schema %s is outdated, write it with make generate-event-schemas", path)
+				}
+			}
+
+			if err = os.MkdirAll("schemas", 0o755); err != nil {
+				t.Fatal(err)
+			}
+			if err = os.WriteFile(path, want, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestIncompatible(t *testing.T) {
+	old := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"id":    map[string]any{"type": "string", "format": "uuid"},
+			"email": map[string]any{"type": "string"},
+			"count": map[string]any{"type": "integer"},
+		},
+		"required":  []any{"id", "email"},
+		"x-topic":   "sso.account.v1",
+		"x-version": float64(2),
+	}
+
+	for _, tc := range []struct {
+		name       string
+		topic      string
+		version    float64
+		properties map[string]any
+		required   []any
+		want       []string
+	}{
+		{
+			name: "field added",
+			properties: map[string]any{
+				"id":    map[string]any{"type": "string", "format": "uuid"},
+				"email": map[string]any{"type": "string"},
+				"count": map[string]any{"type": "integer"},
+				"new":   map[string]any{"type": "string"},
+			},
+			required: []any{"id", "email", "new"},
+		},
+		{
+			name: "field removed",
+			properties: map[string]any{
+				"id":    map[string]any{"type": "string", "format": "uuid"},
+				"email": map[string]any{"type": "string"},
+			},
+			required: []any{"id", "email"},
+			want:     []string{"count was removed"},
+		},
+		{
+			name: "field retyped and made optional",
+			properties: map[string]any{
+				"id":    map[string]any{"type": "string"},
+				"email": map[string]any{"type": "string"},
+				"count": map[string]any{"type": "integer"},
+			},
+			required: []any{"id"},
+			want:     []string{"email is no longer always sent", "id changed its type"},
+		},
+		{
+			name:    "version raised",
+			version: 3,
+			properties: map[string]any{
+				"id":    map[string]any{"type": "string", "format": "uuid"},
+				"email": map[string]any{"type": "string"},
+				"count": map[string]any{"type": "integer"},
+			},
+			required: []any{"id", "email"},
+		},
+		{
+			name:    "topic changed and version lowered",
+			topic:   "sso.account.v2",
+			version: 1,
+			properties: map[string]any{
+				"id":    map[string]any{"type": "string", "format": "uuid"},
+				"email": map[string]any{"type": "string"},
+				"count": map[string]any{"type": "integer"},
+			},
+			required: []any{"id", "email"},
+			want:     []string{"topic changed from sso.account.v1 to sso.account.v2", "version was lowered from 2 to 1"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.topic == "" {
+				tc.topic = "sso.account.v1"
+			}
+			if tc.version == 0 {
+				tc.version = 2
+			}
+
+			cur := map[string]any{
+				"type":       "object",
+				"properties": tc.properties,
+				"required":   tc.required,
+				"x-topic":    tc.topic,
+				"x-version":  tc.version,
+			}
+			if got := incompatible("", old, cur); !slices.Equal(got, tc.want) {
+				t.Fatalf("incompatible() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+// incompatible lists the changes from old to cur a consumer reading old cannot handle: a
+// moved topic, a lowered version, a removed property, a property sent no longer every
+// time and a changed type.
+func incompatible(path string, old, cur map[string]any) []string {
+	var res []string
+	if path == "" {
+		if !reflect.DeepEqual(old["x-topic"], cur["x-topic"]) {
+			res = append(res, fmt.Sprintf("topic changed from %v to %v", old["x-topic"], cur["x-topic"]))
+		}
+
+		oldVersion, _ := old["x-version"].(float64)
+		curVersion, _ := cur["x-version"].(float64)
+		if curVersion < oldVersion {
+			res = append(res, fmt.Sprintf("version was lowered from %v to %v", old["x-version"], cur["x-version"]))
+		}
+	}
+
+	oldProps, ok := old["properties"].(map[string]any)
+	if !ok {
+		if !reflect.DeepEqual(old, cur) {
+			res = append(res, fmt.Sprintf("%s changed its type", path))
+		}
+		return res
+	}
+
+	curProps, ok := cur["properties"].(map[string]any)
+	if !ok {
+		return append(res, fmt.Sprintf("%s is no longer an object", path))
+	}
+
+	curRequired := map[string]bool{}
+	for _, name := range asSlice(cur["required"]) {
+		curRequired[fmt.Sprint(name)] = true
+	}
+
+	for _, name := range asSlice(old["required"]) {
+		name := fmt.Sprint(name)
+		if _, ok = curProps[name]; ok && !curRequired[name] {
+			res = append(res, fmt.Sprintf("%s is no longer always sent", propertyPath(path, name)))
+		}
+	}
+
+	names := make([]string, 0, len(oldProps))
+	for name := range oldProps {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		curProp, ok := curProps[name]
+		if !ok {
+			res = append(res, fmt.Sprintf("%s was removed", propertyPath(path, name)))
+			continue
+		}
+
+		oldSchema, _ := oldProps[name].(map[string]any)
+		curSchema, _ := curProp.(map[string]any)
+		res = append(res, incompatible(propertyPath(path, name), oldSchema, curSchema)...)
+	}
+
+	return res
+}
+
+func asSlice(v any) []any {
+	switch v := v.(type) {
+	case []any:
+		return v
+	case []string:
+		res := make([]any, 0, len(v))
+		for _, s := range v {
+			res = append(res, s)
+		}
+		return res
+	default:
+		return nil
+	}
+}
+
+func propertyPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -3,3 +3,6 @@ package contracts
 const SsoSvcProducer = "sso-svc"
 
 const AccountsTopicV1 = "accounts.v1"
+
+// AccountsCommandsTopicV1 carries the requests other services make to sso-svc.
+const AccountsCommandsTopicV1 = "accounts.commands.v1"
@@ -0,0 +1,20 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/events/contracts"
+)
+
+func (s Service) WriteAccountEmailChanged(
+	ctx context.Context,
+	account entity.Account,
+	oldEmail, newEmail string,
+) error {
+	return s.writeEvent(ctx, contracts.AccountEmailChangeEvent, account.ID, contracts.AccountEmailChangePayload{
+		Account:  contractAccount(account),
+		Email:    newEmail,
+		OldEmail: oldEmail,
+	})
+}
@@ -0,0 +1,24 @@
+package producer
+
+import (
+	"context"
+	"time"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/events/contracts"
+)
+
+func (s Service) WriteAccountEmailVerificationRequested(
+	ctx context.Context,
+	account entity.Account,
+	email string,
+	code string,
+	expiresAt time.Time,
+) error {
+	return s.writeEvent(ctx, contracts.AccountEmailVerificationRequestedEvent, account.ID, contracts.AccountEmailVerificationRequestedPayload{
+		Account:   contractAccount(account),
+		Email:     email,
+		Code:      code,
+		ExpiresAt: expiresAt,
+	})
+}
@@ -2,12 +2,8 @@ package producer
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/google/uuid"
-	"github.com/segmentio/kafka-go"
-	"github.com/umisto/kafkakit/box"
-	"github.com/umisto/kafkakit/header"
 	"github.com/umisto/sso-svc/internal/domain/entity"
 	"github.com/umisto/sso-svc/internal/events/contracts"
 )
@@ -16,33 +12,13 @@ func (s Service) WriteAccountLogin(
 	ctx context.Context,
 	account entity.Account,
 	email string,
+	sessionID uuid.UUID,
+	evictedSessionIDs []uuid.UUID,
 ) error {
-	payload, err := json.Marshal(contracts.AccountLoginPayload{
-		Account: account,
-		Email:   email,
+	return s.writeEvent(ctx, contracts.AccountLoginEvent, account.ID, contracts.AccountLoginPayload{
+		Account:           contractAccount(account),
+		Email:             email,
+		SessionID:         sessionID,
+		EvictedSessionIDs: evictedSessionIDs,
 	})
-	if err != nil {
-		return err
-	}
-
-	eventID := uuid.New()
-
-	_, err = s.outbox.CreateOutboxEvent(
-		ctx,
-		box.OutboxStatusPending,
-		kafka.Message{
-			Topic: contracts.AccountsTopicV1,
-			Key:   []byte(account.ID.String()),
-			Value: payload,
-			Headers: []kafka.Header{
-				{Key: header.EventID, Value: []byte(eventID.String())}, // Outbox will fill this
-				{Key: header.EventType, Value: []byte(contracts.AccountUsernameChangeEvent)},
-				{Key: header.EventVersion, Value: []byte("1")},
-				{Key: header.Producer, Value: []byte(contracts.SsoSvcProducer)},
-				{Key: header.ContentType, Value: []byte("application/json")},
-			},
-		},
-	)
-
-	return err
 }
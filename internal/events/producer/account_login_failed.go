@@ -0,0 +1,26 @@
+package producer
+
+import (
+	"context"
+	"time"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/events/contracts"
+)
+
+func (s Service) WriteAccountLoginFailed(
+	ctx context.Context,
+	account entity.Account,
+	email string,
+	ip string,
+	failedAttempts int32,
+	lockedUntil *time.Time,
+) error {
+	return s.writeEvent(ctx, contracts.AccountLoginFailedEvent, account.ID, contracts.AccountLoginFailedPayload{
+		Account:        contractAccount(account),
+		Email:          email,
+		IP:             ip,
+		FailedAttempts: failedAttempts,
+		LockedUntil:    lockedUntil,
+	})
+}
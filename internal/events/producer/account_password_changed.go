@@ -2,12 +2,7 @@ package producer
 
 import (
 	"context"
-	"encoding/json"
 
-	"github.com/google/uuid"
-	"github.com/segmentio/kafka-go"
-	"github.com/umisto/kafkakit/box"
-	"github.com/umisto/kafkakit/header"
 	"github.com/umisto/sso-svc/internal/domain/entity"
 	"github.com/umisto/sso-svc/internal/events/contracts"
 )
@@ -17,32 +12,8 @@ func (s Service) WriteAccountPasswordChanged(
 	account entity.Account,
 	email string,
 ) error {
-	payload, err := json.Marshal(contracts.AccountPasswordChangePayload{
-		Account: account,
+	return s.writeEvent(ctx, contracts.AccountPasswordChangeEvent, account.ID, contracts.AccountPasswordChangePayload{
+		Account: contractAccount(account),
 		Email:   email,
 	})
-	if err != nil {
-		return err
-	}
-
-	eventID := uuid.New()
-
-	_, err = s.outbox.CreateOutboxEvent(
-		ctx,
-		box.OutboxStatusPending,
-		kafka.Message{
-			Topic: contracts.AccountsTopicV1,
-			Key:   []byte(account.ID.String()),
-			Value: payload,
-			Headers: []kafka.Header{
-				{Key: header.EventID, Value: []byte(eventID.String())}, // Outbox will fill this
-				{Key: header.EventType, Value: []byte(contracts.AccountUsernameChangeEvent)},
-				{Key: header.EventVersion, Value: []byte("1")},
-				{Key: header.Producer, Value: []byte(contracts.SsoSvcProducer)},
-				{Key: header.ContentType, Value: []byte("application/json")},
-			},
-		},
-	)
-
-	return err
 }
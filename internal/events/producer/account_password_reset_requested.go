@@ -0,0 +1,24 @@
+package producer
+
+import (
+	"context"
+	"time"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/events/contracts"
+)
+
+func (s Service) WriteAccountPasswordResetRequested(
+	ctx context.Context,
+	account entity.Account,
+	email string,
+	token string,
+	expiresAt time.Time,
+) error {
+	return s.writeEvent(ctx, contracts.AccountPasswordResetRequestedEvent, account.ID, contracts.AccountPasswordResetRequestedPayload{
+		Account:   contractAccount(account),
+		Email:     email,
+		Token:     token,
+		ExpiresAt: expiresAt,
+	})
+}
@@ -0,0 +1,26 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/events/contracts"
+)
+
+func (s Service) WriteAccountRoleChanged(
+	ctx context.Context,
+	account entity.Account,
+	email string,
+	oldRole string,
+	reason string,
+	initiatorID uuid.UUID,
+) error {
+	return s.writeEvent(ctx, contracts.AccountRoleChangeEvent, account.ID, contracts.AccountRoleChangePayload{
+		Account:     contractAccount(account),
+		Email:       email,
+		OldRole:     oldRole,
+		Reason:      reason,
+		InitiatorID: initiatorID,
+	})
+}
@@ -0,0 +1,24 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/events/contracts"
+)
+
+func (s Service) WriteAccountSessionCompromised(
+	ctx context.Context,
+	account entity.Account,
+	email string,
+	sessionID uuid.UUID,
+	allSessionsRevoked bool,
+) error {
+	return s.writeEvent(ctx, contracts.AccountSessionCompromisedEvent, account.ID, contracts.AccountSessionCompromisedPayload{
+		Account:            contractAccount(account),
+		Email:              email,
+		SessionID:          sessionID,
+		AllSessionsRevoked: allSessionsRevoked,
+	})
+}
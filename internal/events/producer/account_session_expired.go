@@ -0,0 +1,25 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/events/contracts"
+)
+
+func (s Service) WriteAccountSessionExpired(
+	ctx context.Context,
+	account entity.Account,
+	email string,
+	session entity.Session,
+	reason string,
+) error {
+	return s.writeEvent(ctx, contracts.AccountSessionExpiredEvent, account.ID, contracts.AccountSessionExpiredPayload{
+		Account:   contractAccount(account),
+		Email:     email,
+		SessionID: session.ID,
+		Reason:    reason,
+		CreatedAt: session.CreatedAt,
+		LastUsed:  session.LastUsed,
+	})
+}
@@ -0,0 +1,26 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/events/contracts"
+)
+
+func (s Service) WriteAccountStatusChanged(
+	ctx context.Context,
+	account entity.Account,
+	email string,
+	oldStatus string,
+	reason string,
+	initiatorID uuid.UUID,
+) error {
+	return s.writeEvent(ctx, contracts.AccountStatusChangeEvent, account.ID, contracts.AccountStatusChangePayload{
+		Account:     contractAccount(account),
+		Email:       email,
+		OldStatus:   oldStatus,
+		Reason:      reason,
+		InitiatorID: initiatorID,
+	})
+}
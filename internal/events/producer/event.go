@@ -0,0 +1,66 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/umisto/kafkakit/box"
+	"github.com/umisto/kafkakit/header"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/events/contracts"
+)
+
+// writeEvent stores the event in the outbox, keyed by the account it is about. Its topic
+// and version come from the contracts, the payload has to be of the registered type.
+func (s Service) writeEvent(ctx context.Context, eventType string, accountID uuid.UUID, payload any) error {
+	event, ok := contracts.EventByType(eventType)
+	if !ok {
+		return fmt.Errorf("event type %s is not registered", eventType)
+	}
+	if reflect.TypeOf(payload) != reflect.TypeOf(event.Payload) {
+		return fmt.Errorf("payload %T does not match %s version %d", payload, eventType, event.Version)
+	}
+
+	value, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	eventID := uuid.New()
+
+	_, err = s.outbox.CreateOutboxEvent(
+		ctx,
+		box.OutboxStatusPending,
+		kafka.Message{
+			Topic: event.Topic,
+			Key:   []byte(accountID.String()),
+			Value: value,
+			Headers: []kafka.Header{
+				{Key: header.EventID, Value: []byte(eventID.String())},
+				{Key: header.EventType, Value: []byte(event.Type)},
+				{Key: header.EventVersion, Value: []byte(strconv.Itoa(int(event.Version)))},
+				{Key: header.Producer, Value: []byte(contracts.SsoSvcProducer)},
+				{Key: header.ContentType, Value: []byte("application/json")},
+			},
+		},
+	)
+
+	return err
+}
+
+func contractAccount(account entity.Account) contracts.Account {
+	return contracts.Account{
+		ID:                account.ID,
+		Username:          account.Username,
+		Role:              account.Role,
+		Status:            account.Status,
+		CreatedAt:         account.CreatedAt,
+		UpdatedAt:         account.UpdatedAt,
+		UsernameUpdatedAt: account.UsernameUpdatedAt,
+	}
+}
@@ -0,0 +1,32 @@
+package producer
+
+import (
+	"expvar"
+)
+
+// Outbox metrics, published by expvar under "outbox".
+var (
+	outboxMetrics = expvar.NewMap("outbox")
+
+	outboxPending      = new(expvar.Int)
+	outboxProcessing   = new(expvar.Int)
+	outboxFailed       = new(expvar.Int)
+	outboxLagSeconds   = new(expvar.Float)
+	outboxSent         = new(expvar.Int)
+	outboxRetried      = new(expvar.Int)
+	outboxDeadLettered = new(expvar.Int)
+	outboxRecovered    = new(expvar.Int)
+)
+
+func init() {
+	// pending, processing, failed and lag_seconds describe the outbox at the last poll,
+	// lag_seconds is the age of the oldest event not sent yet
+	outboxMetrics.Set("pending", outboxPending)
+	outboxMetrics.Set("processing", outboxProcessing)
+	outboxMetrics.Set("failed", outboxFailed)
+	outboxMetrics.Set("lag_seconds", outboxLagSeconds)
+	outboxMetrics.Set("sent_total", outboxSent)
+	outboxMetrics.Set("retried_total", outboxRetried)
+	outboxMetrics.Set("dead_lettered_total", outboxDeadLettered)
+	outboxMetrics.Set("recovered_total", outboxRecovered)
+}
@@ -2,6 +2,10 @@ package producer
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,12 +14,45 @@ import (
 	"github.com/umisto/logium"
 )
 
+type Config struct {
+	Brokers []string
+	// BatchSize is how many events are claimed and written to kafka at once.
+	BatchSize int32
+	// Lease is how long claimed events stay with this producer. Events still processing
+	// after it are taken to be abandoned and are queued again, so it has to outlast a
+	// write to kafka.
+	Lease time.Duration
+	// MaxAttempts is how often an event is tried before it is marked failed and copied
+	// to DLQTopic.
+	MaxAttempts    int32
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// DLQTopic receives the events given up on, empty to only mark them failed.
+	DLQTopic string
+}
+
 type Service struct {
 	log    logium.Logger
-	addr   []string
+	cfg    Config
 	outbox outbox
 }
 
+// OutboxStats describes the backlog of the outbox, OldestPendingAt is nil when every
+// event is sent or failed.
+type OutboxStats struct {
+	Pending         int64
+	Processing      int64
+	Failed          int64
+	OldestPendingAt *time.Time
+}
+
+// OutboxClaim is a batch of events leased to one producer, ID tells its marks apart from
+// those of a producer holding the same events after the lease ran out.
+type OutboxClaim struct {
+	ID     uuid.UUID
+	Events []box.OutboxEvent
+}
+
 type outbox interface {
 	CreateOutboxEvent(
 		ctx context.Context,
@@ -24,71 +61,241 @@ type outbox interface {
 	) (box.OutboxEvent, error)
 
 	GetOutboxEventByID(ctx context.Context, id uuid.UUID) (box.OutboxEvent, error)
-	GetPendingOutboxEvents(ctx context.Context, limit int32) ([]box.OutboxEvent, error)
-	MarkOutboxEventsSent(ctx context.Context, ids []uuid.UUID) ([]box.OutboxEvent, error)
-	MarkOutboxEventsAsFailed(ctx context.Context, ids []uuid.UUID) ([]box.OutboxEvent, error)
-	MarkOutboxEventsAsPending(ctx context.Context, ids []uuid.UUID, delay time.Duration) ([]box.OutboxEvent, error)
+	ClaimOutboxEvents(ctx context.Context, limit int32, lease time.Duration) (OutboxClaim, error)
+	RecoverOutboxEvents(ctx context.Context) ([]box.OutboxEvent, error)
+	MarkOutboxEventsSent(ctx context.Context, claimID uuid.UUID, ids []uuid.UUID) ([]box.OutboxEvent, error)
+	MarkOutboxEventsAsFailed(ctx context.Context, claimID uuid.UUID, ids []uuid.UUID) ([]box.OutboxEvent, error)
+	MarkOutboxEventsAsPending(
+		ctx context.Context,
+		claimID uuid.UUID,
+		ids []uuid.UUID,
+		delay time.Duration,
+	) ([]box.OutboxEvent, error)
+	GetOutboxStats(ctx context.Context) (OutboxStats, error)
 }
 
-func New(log logium.Logger, addr []string, outbox outbox) *Service {
+const (
+	defaultBatchSize   = 100
+	defaultLease       = 30 * time.Second
+	defaultMaxAttempts = 12
+)
+
+func New(log logium.Logger, cfg Config, outbox outbox) (*Service, error) {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = defaultLease
+	}
+	// with no attempts every event would be marked failed without being published
+	switch {
+	case cfg.MaxAttempts < 0:
+		return nil, fmt.Errorf("outbox max attempts must not be negative, got %d", cfg.MaxAttempts)
+	case cfg.MaxAttempts == 0:
+		cfg.MaxAttempts = defaultMaxAttempts
+	}
+
 	return &Service{
 		log:    log,
-		addr:   addr,
+		cfg:    cfg,
 		outbox: outbox,
-	}
+	}, nil
 }
 
-const eventOutboxRetryDelay = 1 * time.Minute
+// Headers added to the events copied to the dead letter topic.
+const (
+	dlqOriginalTopicHeader = "dlq_original_topic"
+	dlqAttemptsHeader      = "dlq_attempts"
+	dlqErrorHeader         = "dlq_error"
+)
 
+// Run sends the outbox to kafka until ctx is done. Every poll claims a batch of events, so
+// any number of replicas can run it side by side without sending an event twice.
 func (s Service) Run(ctx context.Context) {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
 
 	publisher := kafka.Writer{
-		Addr:         kafka.TCP(s.addr...),
+		Addr:         kafka.TCP(s.cfg.Brokers...),
 		Balancer:     &kafka.LeastBytes{},
 		RequiredAcks: kafka.RequireAll,
 		Compression:  kafka.Snappy,
+		BatchSize:    int(s.cfg.BatchSize),
 		BatchTimeout: 50 * time.Millisecond,
 	}
+	defer func() {
+		if err := publisher.Close(); err != nil {
+			s.log.Errorf("outbox: close writer: %v", err)
+		}
+	}()
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			events, err := s.outbox.GetPendingOutboxEvents(ctx, 100)
+			s.recover(ctx)
+
+			claim, err := s.outbox.ClaimOutboxEvents(ctx, s.cfg.BatchSize, s.cfg.Lease)
 			if err != nil {
-				s.log.Errorf("outbox.GetPendingOutboxEvents: %v", err)
+				s.log.Errorf("outbox.ClaimOutboxEvents: %v", err)
 				continue
 			}
 
-			var sentIDs []uuid.UUID
-			var NonSentIDs []uuid.UUID
-
-			for _, event := range events {
-				err = publisher.WriteMessages(ctx, event.ToMessage())
-				if err != nil {
-					NonSentIDs = append(NonSentIDs, event.ID)
-					s.log.Debugf("outbox: publish event ID %s: %v", event.ID, err)
-					continue
-				}
-				sentIDs = append(sentIDs, event.ID)
+			if len(claim.Events) > 0 {
+				s.publish(ctx, &publisher, claim)
 			}
 
-			if len(sentIDs) > 0 {
-				_, err = s.outbox.MarkOutboxEventsSent(ctx, sentIDs)
-				if err != nil {
-					s.log.Debugf("outbox: mark events as sent: %v", err)
-				}
-			}
+			s.updateStats(ctx)
+		}
+	}
+}
 
-			if len(NonSentIDs) > 0 {
-				_, err = s.outbox.MarkOutboxEventsAsPending(ctx, NonSentIDs, eventOutboxRetryDelay)
-				if err != nil {
-					s.log.Debugf("outbox: delay events: %v", err)
-				}
-			}
+// publish writes the claimed events to kafka in one call. The events kafka took are marked
+// sent, the others are retried later.
+func (s Service) publish(ctx context.Context, publisher *kafka.Writer, claim OutboxClaim) {
+	events := claim.Events
+
+	messages := make([]kafka.Message, 0, len(events))
+	for _, event := range events {
+		messages = append(messages, event.ToMessage())
+	}
+
+	err := publisher.WriteMessages(ctx, messages...)
+
+	// WriteErrors holds the error of every message by its index, any other error is the
+	// error of the whole batch
+	var writeErrs kafka.WriteErrors
+	if err != nil && !errors.As(err, &writeErrs) {
+		writeErrs = make(kafka.WriteErrors, len(events))
+		for i := range writeErrs {
+			writeErrs[i] = err
 		}
 	}
+
+	sentIDs := make([]uuid.UUID, 0, len(events))
+	for i, event := range events {
+		if i < len(writeErrs) && writeErrs[i] != nil {
+			s.retryLater(ctx, publisher, claim.ID, event, writeErrs[i])
+			continue
+		}
+		sentIDs = append(sentIDs, event.ID)
+	}
+
+	if len(sentIDs) == 0 {
+		return
+	}
+
+	// left processing on failure, the events are sent again once their lease runs out
+	marked, err := s.outbox.MarkOutboxEventsSent(ctx, claim.ID, sentIDs)
+	if err != nil {
+		s.log.Errorf("outbox: mark %d events as sent: %v", len(sentIDs), err)
+		return
+	}
+	if len(marked) < len(sentIDs) {
+		s.log.Warnf("outbox: %d events sent after their lease ran out, they are sent again", len(sentIDs)-len(marked))
+	}
+
+	outboxSent.Add(int64(len(marked)))
+}
+
+// recover queues again the events a producer claimed and did not mark before its lease
+// ran out, e.g. because it was stopped in between.
+func (s Service) recover(ctx context.Context) {
+	events, err := s.outbox.RecoverOutboxEvents(ctx)
+	if err != nil {
+		s.log.Errorf("outbox.RecoverOutboxEvents: %v", err)
+		return
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	s.log.Warnf("outbox: %d events with an expired lease queued again", len(events))
+	outboxRecovered.Add(int64(len(events)))
+}
+
+// retryLater delays the event after a failed publish, once it is out of attempts it is
+// marked failed and copied to the dead letter topic.
+func (s Service) retryLater(
+	ctx context.Context,
+	publisher *kafka.Writer,
+	claimID uuid.UUID,
+	event box.OutboxEvent,
+	cause error,
+) {
+	attempt := event.Attempts + 1
+	if attempt < s.cfg.MaxAttempts {
+		delay := retryDelay(attempt, s.cfg.RetryBaseDelay, s.cfg.RetryMaxDelay)
+		s.log.Debugf("outbox: publish event ID %s, attempt %d, retry in %s: %v", event.ID, attempt, delay, cause)
+
+		if _, err := s.outbox.MarkOutboxEventsAsPending(ctx, claimID, []uuid.UUID{event.ID}, delay); err != nil {
+			s.log.Debugf("outbox: delay event %s: %v", event.ID, err)
+			return
+		}
+
+		outboxRetried.Add(1)
+		return
+	}
+
+	s.log.Errorf("outbox: event %s of type %s failed after %d attempts: %v", event.ID, event.Type, attempt, cause)
+
+	if s.cfg.DLQTopic != "" {
+		msg := event.ToMessage()
+		msg.Headers = append(msg.Headers,
+			kafka.Header{Key: dlqOriginalTopicHeader, Value: []byte(msg.Topic)},
+			kafka.Header{Key: dlqAttemptsHeader, Value: []byte(strconv.Itoa(int(attempt)))},
+			kafka.Header{Key: dlqErrorHeader, Value: []byte(cause.Error())},
+		)
+		msg.Topic = s.cfg.DLQTopic
+
+		// the event stays failed in the outbox either way, it can be replayed from there
+		if err := publisher.WriteMessages(ctx, msg); err != nil {
+			s.log.Errorf("outbox: copy event %s to dead letter topic %s: %v", event.ID, s.cfg.DLQTopic, err)
+		}
+	}
+
+	if _, err := s.outbox.MarkOutboxEventsAsFailed(ctx, claimID, []uuid.UUID{event.ID}); err != nil {
+		s.log.Errorf("outbox: mark event %s as failed: %v", event.ID, err)
+		return
+	}
+
+	outboxDeadLettered.Add(1)
+}
+
+func (s Service) updateStats(ctx context.Context) {
+	stats, err := s.outbox.GetOutboxStats(ctx)
+	if err != nil {
+		s.log.Debugf("outbox: get stats: %v", err)
+		return
+	}
+
+	outboxPending.Set(stats.Pending)
+	outboxProcessing.Set(stats.Processing)
+	outboxFailed.Set(stats.Failed)
+
+	var lag float64
+	if stats.OldestPendingAt != nil {
+		lag = time.Since(*stats.OldestPendingAt).Seconds()
+	}
+	outboxLagSeconds.Set(lag)
+}
+
+// retryDelay doubles the base delay with every attempt up to max, then picks a random
+// delay between the half and the whole of it, so events failed together do not retry
+// together.
+func retryDelay(attempt int32, base, max time.Duration) time.Duration {
+	delay := base
+	for i := int32(1); i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + rand.N(delay-half+1)
 }
@@ -0,0 +1,39 @@
+package producer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	for _, tc := range []struct {
+		attempt int32
+		want    time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{4, 8 * time.Second},
+		{10, time.Minute},
+	} {
+		for range 100 {
+			got := retryDelay(tc.attempt, time.Second, time.Minute)
+			if got < tc.want/2 || got > tc.want {
+				t.Fatalf("retryDelay(%d) = %s, want between %s and %s", tc.attempt, got, tc.want/2, tc.want)
+			}
+		}
+	}
+}
+
+func TestNewMaxAttempts(t *testing.T) {
+	s, err := New(nil, Config{}, nil)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if s.cfg.MaxAttempts != defaultMaxAttempts {
+		t.Fatalf("New: max attempts %d, want the default %d", s.cfg.MaxAttempts, defaultMaxAttempts)
+	}
+
+	if _, err = New(nil, Config{MaxAttempts: -1}, nil); err == nil {
+		t.Fatalf("New: expected an error for negative max attempts")
+	}
+}
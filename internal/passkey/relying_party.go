@@ -0,0 +1,177 @@
+package passkey
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+type Config struct {
+	RPID          string
+	RPDisplayName string
+	RPOrigins     []string
+}
+
+type Service struct {
+	wa *webauthn.WebAuthn
+}
+
+func NewRelyingParty(cfg Config) (Service, error) {
+	wa, err := webauthn.New(&webauthn.Config{
+		RPID:          cfg.RPID,
+		RPDisplayName: cfg.RPDisplayName,
+		RPOrigins:     cfg.RPOrigins,
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        webauthn.TimeoutConfig{Enforce: true},
+			Registration: webauthn.TimeoutConfig{Enforce: true},
+		},
+	})
+	if err != nil {
+		return Service{}, fmt.Errorf("create webauthn relying party: %w", err)
+	}
+
+	return Service{wa: wa}, nil
+}
+
+// BeginRegistration returns the credential creation options for the client together with
+// the serialized ceremony session that has to be passed back to FinishRegistration.
+func (s Service) BeginRegistration(
+	account entity.Account,
+	name string,
+	existing []entity.Passkey,
+) (json.RawMessage, []byte, time.Time, error) {
+	u := newUser(account, name, existing)
+
+	creation, session, err := s.wa.BeginRegistration(u,
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+		webauthn.WithExclusions(webauthn.Credentials(u.credentials).CredentialDescriptors()),
+	)
+	if err != nil {
+		return nil, nil, time.Time{}, fmt.Errorf("begin passkey registration: %w", err)
+	}
+
+	return marshalCeremony(creation, session)
+}
+
+func (s Service) FinishRegistration(
+	account entity.Account,
+	name string,
+	existing []entity.Passkey,
+	session []byte,
+	response []byte,
+) (entity.Passkey, error) {
+	var data webauthn.SessionData
+	if err := json.Unmarshal(session, &data); err != nil {
+		return entity.Passkey{}, fmt.Errorf("decode passkey registration session: %w", err)
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return entity.Passkey{}, fmt.Errorf("parse passkey registration response: %w", err)
+	}
+
+	credential, err := s.wa.CreateCredential(newUser(account, name, existing), data, parsed)
+	if err != nil {
+		return entity.Passkey{}, fmt.Errorf("verify passkey registration: %w", err)
+	}
+
+	passkey := entity.Passkey{AccountID: account.ID}
+	applyCredential(&passkey, *credential)
+	passkey.PublicKey = credential.PublicKey
+	passkey.AttestationType = credential.AttestationType
+	passkey.Transports = make([]string, 0, len(credential.Transport))
+	for _, t := range credential.Transport {
+		passkey.Transports = append(passkey.Transports, string(t))
+	}
+
+	return passkey, nil
+}
+
+// BeginLogin starts a discoverable (usernameless) login, the authenticator picks the
+// account by the user handle stored in the passkey.
+func (s Service) BeginLogin() (json.RawMessage, []byte, time.Time, error) {
+	assertion, session, err := s.wa.BeginDiscoverableLogin()
+	if err != nil {
+		return nil, nil, time.Time{}, fmt.Errorf("begin passkey login: %w", err)
+	}
+
+	return marshalCeremony(assertion, session)
+}
+
+// FinishLogin verifies the assertion and returns the used passkey with its sign count
+// and backup flags updated. lookup loads the passkeys of the account named by the user handle.
+func (s Service) FinishLogin(
+	session []byte,
+	response []byte,
+	lookup func(accountID uuid.UUID) ([]entity.Passkey, error),
+) (entity.Passkey, error) {
+	var data webauthn.SessionData
+	if err := json.Unmarshal(session, &data); err != nil {
+		return entity.Passkey{}, fmt.Errorf("decode passkey login session: %w", err)
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return entity.Passkey{}, fmt.Errorf("parse passkey login response: %w", err)
+	}
+
+	var passkeys []entity.Passkey
+	handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+		accountID, err := uuid.FromBytes(userHandle)
+		if err != nil {
+			return nil, fmt.Errorf("parse passkey user handle: %w", err)
+		}
+
+		passkeys, err = lookup(accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		return newUser(entity.Account{ID: accountID}, "", passkeys), nil
+	}
+
+	_, credential, err := s.wa.ValidatePasskeyLogin(handler, data, parsed)
+	if err != nil {
+		return entity.Passkey{}, fmt.Errorf("verify passkey login: %w", err)
+	}
+	if credential.Authenticator.CloneWarning {
+		return entity.Passkey{}, fmt.Errorf("passkey sign count did not increase, authenticator may be cloned")
+	}
+
+	for _, passkey := range passkeys {
+		if bytes.Equal(passkey.CredentialID, credential.ID) {
+			applyCredential(&passkey, *credential)
+			return passkey, nil
+		}
+	}
+
+	return entity.Passkey{}, fmt.Errorf("verified passkey credential is not registered")
+}
+
+func marshalCeremony(options any, session *webauthn.SessionData) (json.RawMessage, []byte, time.Time, error) {
+	rawOptions, err := json.Marshal(options)
+	if err != nil {
+		return nil, nil, time.Time{}, fmt.Errorf("encode passkey options: %w", err)
+	}
+
+	rawSession, err := json.Marshal(session)
+	if err != nil {
+		return nil, nil, time.Time{}, fmt.Errorf("encode passkey session: %w", err)
+	}
+
+	return rawOptions, rawSession, session.Expires, nil
+}
+
+func applyCredential(passkey *entity.Passkey, credential webauthn.Credential) {
+	passkey.CredentialID = credential.ID
+	passkey.SignCount = credential.Authenticator.SignCount
+	passkey.AAGUID = credential.Authenticator.AAGUID
+	passkey.BackupEligible = credential.Flags.BackupEligible
+	passkey.BackupState = credential.Flags.BackupState
+}
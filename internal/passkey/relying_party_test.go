@@ -0,0 +1,167 @@
+package passkey
+
+import (
+	"testing"
+
+	"github.com/descope/virtualwebauthn"
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+func newTestRelyingParty(t *testing.T) (Service, virtualwebauthn.RelyingParty) {
+	rp, err := NewRelyingParty(Config{
+		RPID:          "example.com",
+		RPDisplayName: "Example",
+		RPOrigins:     []string{"https://example.com"},
+	})
+	if err != nil {
+		t.Fatalf("NewRelyingParty: %v", err)
+	}
+
+	return rp, virtualwebauthn.RelyingParty{Name: "Example", ID: "example.com", Origin: "https://example.com"}
+}
+
+func registerPasskey(
+	t *testing.T,
+	rp Service,
+	vrp virtualwebauthn.RelyingParty,
+	authenticator *virtualwebauthn.Authenticator,
+	account entity.Account,
+) (entity.Passkey, virtualwebauthn.Credential) {
+	options, session, _, err := rp.BeginRegistration(account, "user@example.com", nil)
+	if err != nil {
+		t.Fatalf("BeginRegistration: %v", err)
+	}
+
+	attestation, err := virtualwebauthn.ParseAttestationOptions(string(options))
+	if err != nil {
+		t.Fatalf("ParseAttestationOptions: %v", err)
+	}
+
+	credential := virtualwebauthn.NewCredential(virtualwebauthn.KeyTypeEC2)
+	response := virtualwebauthn.CreateAttestationResponse(vrp, *authenticator, credential, *attestation)
+
+	passkey, err := rp.FinishRegistration(account, "user@example.com", nil, session, []byte(response))
+	if err != nil {
+		t.Fatalf("FinishRegistration: %v", err)
+	}
+
+	passkey.ID = uuid.New()
+	authenticator.AddCredential(credential)
+
+	return passkey, credential
+}
+
+func TestRegistrationAndDiscoverableLogin(t *testing.T) {
+	rp, vrp := newTestRelyingParty(t)
+
+	account := entity.Account{ID: uuid.New(), Username: "user"}
+	authenticator := virtualwebauthn.NewAuthenticatorWithOptions(virtualwebauthn.AuthenticatorOptions{
+		UserHandle: account.ID[:],
+	})
+
+	passkey, credential := registerPasskey(t, rp, vrp, &authenticator, account)
+	if passkey.AccountID != account.ID {
+		t.Fatalf("FinishRegistration: expected account %s, got %s", account.ID, passkey.AccountID)
+	}
+	if len(passkey.PublicKey) == 0 {
+		t.Fatalf("FinishRegistration: expected public key to be set")
+	}
+
+	options, session, _, err := rp.BeginLogin()
+	if err != nil {
+		t.Fatalf("BeginLogin: %v", err)
+	}
+
+	assertion, err := virtualwebauthn.ParseAssertionOptions(string(options))
+	if err != nil {
+		t.Fatalf("ParseAssertionOptions: %v", err)
+	}
+
+	credential.Counter = passkey.SignCount + 1
+	response := virtualwebauthn.CreateAssertionResponse(vrp, authenticator, credential, *assertion)
+
+	var lookedUp uuid.UUID
+	used, err := rp.FinishLogin(session, []byte(response), func(accountID uuid.UUID) ([]entity.Passkey, error) {
+		lookedUp = accountID
+		return []entity.Passkey{passkey}, nil
+	})
+	if err != nil {
+		t.Fatalf("FinishLogin: %v", err)
+	}
+	if lookedUp != account.ID {
+		t.Fatalf("FinishLogin: expected lookup of account %s, got %s", account.ID, lookedUp)
+	}
+	if used.ID != passkey.ID {
+		t.Fatalf("FinishLogin: expected passkey %s, got %s", passkey.ID, used.ID)
+	}
+	if used.SignCount != credential.Counter {
+		t.Fatalf("FinishLogin: expected sign count %d, got %d", credential.Counter, used.SignCount)
+	}
+}
+
+func TestLoginRejectsReplayedSession(t *testing.T) {
+	rp, vrp := newTestRelyingParty(t)
+
+	account := entity.Account{ID: uuid.New(), Username: "user"}
+	authenticator := virtualwebauthn.NewAuthenticatorWithOptions(virtualwebauthn.AuthenticatorOptions{
+		UserHandle: account.ID[:],
+	})
+
+	passkey, credential := registerPasskey(t, rp, vrp, &authenticator, account)
+
+	_, session, _, err := rp.BeginLogin()
+	if err != nil {
+		t.Fatalf("BeginLogin: %v", err)
+	}
+
+	options, _, _, err := rp.BeginLogin()
+	if err != nil {
+		t.Fatalf("BeginLogin: %v", err)
+	}
+
+	assertion, err := virtualwebauthn.ParseAssertionOptions(string(options))
+	if err != nil {
+		t.Fatalf("ParseAssertionOptions: %v", err)
+	}
+
+	credential.Counter = passkey.SignCount + 1
+	response := virtualwebauthn.CreateAssertionResponse(vrp, authenticator, credential, *assertion)
+
+	_, err = rp.FinishLogin(session, []byte(response), func(uuid.UUID) ([]entity.Passkey, error) {
+		return []entity.Passkey{passkey}, nil
+	})
+	if err == nil {
+		t.Fatalf("FinishLogin: expected error for assertion signed over another challenge")
+	}
+}
+
+func TestLoginRejectsUnknownCredential(t *testing.T) {
+	rp, vrp := newTestRelyingParty(t)
+
+	account := entity.Account{ID: uuid.New(), Username: "user"}
+	authenticator := virtualwebauthn.NewAuthenticatorWithOptions(virtualwebauthn.AuthenticatorOptions{
+		UserHandle: account.ID[:],
+	})
+
+	_, credential := registerPasskey(t, rp, vrp, &authenticator, account)
+
+	options, session, _, err := rp.BeginLogin()
+	if err != nil {
+		t.Fatalf("BeginLogin: %v", err)
+	}
+
+	assertion, err := virtualwebauthn.ParseAssertionOptions(string(options))
+	if err != nil {
+		t.Fatalf("ParseAssertionOptions: %v", err)
+	}
+
+	response := virtualwebauthn.CreateAssertionResponse(vrp, authenticator, credential, *assertion)
+
+	_, err = rp.FinishLogin(session, []byte(response), func(uuid.UUID) ([]entity.Passkey, error) {
+		return nil, nil
+	})
+	if err == nil {
+		t.Fatalf("FinishLogin: expected error for credential that is not registered")
+	}
+}
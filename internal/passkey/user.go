@@ -0,0 +1,62 @@
+package passkey
+
+import (
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+// user adapts an account and its passkeys to webauthn.User. The account id is used as
+// the user handle, so a discoverable login resolves straight to the account.
+type user struct {
+	account     entity.Account
+	name        string
+	credentials []webauthn.Credential
+}
+
+func newUser(account entity.Account, name string, passkeys []entity.Passkey) user {
+	credentials := make([]webauthn.Credential, 0, len(passkeys))
+	for _, p := range passkeys {
+		transports := make([]protocol.AuthenticatorTransport, 0, len(p.Transports))
+		for _, t := range p.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(t))
+		}
+
+		credentials = append(credentials, webauthn.Credential{
+			ID:              p.CredentialID,
+			PublicKey:       p.PublicKey,
+			AttestationType: p.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: p.BackupEligible,
+				BackupState:    p.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    p.AAGUID,
+				SignCount: p.SignCount,
+			},
+		})
+	}
+
+	return user{
+		account:     account,
+		name:        name,
+		credentials: credentials,
+	}
+}
+
+func (u user) WebAuthnID() []byte {
+	return u.account.ID[:]
+}
+
+func (u user) WebAuthnName() string {
+	return u.name
+}
+
+func (u user) WebAuthnDisplayName() string {
+	return u.account.Username
+}
+
+func (u user) WebAuthnCredentials() []webauthn.Credential {
+	return u.credentials
+}
@@ -0,0 +1,66 @@
+package reaper
+
+import (
+	"context"
+	"time"
+
+	"github.com/umisto/logium"
+)
+
+type sessionsDeleter interface {
+	DeleteExpiredSessions(ctx context.Context, limit uint64) (int, error)
+}
+
+// Sessions deletes expired sessions in the background. Refresh and session checks
+// reject an expired session on their own, the sweep only clears them out of the table
+// and announces the expiry.
+type Sessions struct {
+	log       logium.Logger
+	domain    sessionsDeleter
+	batchSize uint64
+}
+
+func NewSessions(log logium.Logger, domain sessionsDeleter, batchSize uint64) *Sessions {
+	return &Sessions{
+		log:       log,
+		domain:    domain,
+		batchSize: batchSize,
+	}
+}
+
+// Run sweeps every interval until ctx is done.
+func (r *Sessions) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.sweep(ctx)
+		}
+	}
+}
+
+// sweep deletes batches until one comes back short, so a backlog is cleared in one tick
+// without holding a single long transaction.
+func (r *Sessions) sweep(ctx context.Context) {
+	total := 0
+	for ctx.Err() == nil {
+		deleted, err := r.domain.DeleteExpiredSessions(ctx, r.batchSize)
+		if err != nil {
+			r.log.WithError(err).Error("failed to delete expired sessions")
+			break
+		}
+
+		total += deleted
+		if uint64(deleted) < r.batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		r.log.Infof("deleted %d expired sessions", total)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/umisto/restkit/pagi"
 	"github.com/umisto/sso-svc/internal/domain/entity"
 	"github.com/umisto/sso-svc/internal/domain/modules/auth"
 	"github.com/umisto/sso-svc/internal/repo/pgdb"
@@ -40,7 +41,7 @@ func (r *Repository) CreateAccount(ctx context.Context, params auth.CreateAccoun
 		emailRow := pgdb.AccountEmail{
 			AccountID: accountID,
 			Email:     params.Email,
-			Verified:  false,
+			Verified:  params.EmailVerified,
 			CreatedAt: now,
 			UpdatedAt: now,
 		}
@@ -57,7 +58,23 @@ func (r *Repository) CreateAccount(ctx context.Context, params auth.CreateAccoun
 			UpdatedAt: now,
 		}
 
-		return r.sql.passwords.Insert(ctx, passwordRow)
+		err = r.sql.passwords.Insert(ctx, passwordRow)
+		if err != nil {
+			return err
+		}
+
+		if params.Identity == nil {
+			return nil
+		}
+
+		return r.sql.identities.Insert(ctx, pgdb.AccountIdentity{
+			ID:        uuid.New(),
+			AccountID: accountID,
+			Provider:  params.Identity.Provider,
+			Subject:   params.Identity.Subject,
+			Email:     params.Identity.Email,
+			LinkedAt:  now,
+		})
 	})
 	if err != nil {
 		return entity.Account{}, err
@@ -146,6 +163,71 @@ func (r *Repository) UpdateAccountStatus(ctx context.Context, accountID uuid.UUI
 	return accs[0].ToEntity(), nil
 }
 
+func (r *Repository) UpdateAccountRole(ctx context.Context, accountID uuid.UUID, role string) (entity.Account, error) {
+	accs, err := r.sql.accounts.New().
+		FilterID(accountID).
+		UpdateRole(role).
+		Update(ctx)
+	if err != nil {
+		return entity.Account{}, err
+	}
+
+	if len(accs) != 1 {
+		return entity.Account{}, fmt.Errorf("expected to update 1 account, updated %d", len(accs))
+	}
+	return accs[0].ToEntity(), nil
+}
+
+func (r *Repository) GetAccounts(
+	ctx context.Context,
+	filter auth.AccountsFilter,
+	page, size int32,
+) (entity.AccountsCollection, error) {
+	limit, offset := pagi.PagConvert(page, size)
+
+	query := r.sql.accounts.New()
+	if filter.Status != "" {
+		query = query.FilterStatus(filter.Status)
+	}
+	if filter.Role != "" {
+		query = query.FilterRole(filter.Role)
+	}
+	if filter.EmailPrefix != "" {
+		query = query.FilterEmailPrefix(filter.EmailPrefix)
+	}
+	if filter.UsernamePrefix != "" {
+		query = query.FilterUsernamePrefix(filter.UsernamePrefix)
+	}
+	if filter.CreatedAfter != nil {
+		query = query.FilterCreatedAfter(*filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.FilterCreatedBefore(*filter.CreatedBefore)
+	}
+
+	rows, err := query.OrderCreatedAt(false).Page(uint64(limit), uint64(offset)).Select(ctx)
+	if err != nil {
+		return entity.AccountsCollection{}, err
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return entity.AccountsCollection{}, err
+	}
+
+	accounts := make([]entity.Account, 0, len(rows))
+	for _, row := range rows {
+		accounts = append(accounts, row.ToEntity())
+	}
+
+	return entity.AccountsCollection{
+		Data:  accounts,
+		Page:  page,
+		Size:  size,
+		Total: int64(total),
+	}, nil
+}
+
 func (r *Repository) GetAccountEmail(ctx context.Context, accountID uuid.UUID) (entity.AccountEmail, error) {
 	acc, err := r.sql.emails.New().FilterAccountID(accountID).Get(ctx)
 	switch {
@@ -177,6 +259,25 @@ func (r *Repository) UpdateAccountEmailVerification(
 	return accs[0].ToEntity(), nil
 }
 
+func (r *Repository) UpdateAccountEmailVerificationSentAt(
+	ctx context.Context,
+	accountID uuid.UUID,
+	sentAt time.Time,
+) (entity.AccountEmail, error) {
+	accs, err := r.sql.emails.New().
+		FilterAccountID(accountID).
+		UpdateVerificationSentAt(sentAt).
+		Update(ctx)
+	if err != nil {
+		return entity.AccountEmail{}, err
+	}
+
+	if len(accs) != 1 {
+		return entity.AccountEmail{}, fmt.Errorf("expected to update 1 account, updated %d", len(accs))
+	}
+	return accs[0].ToEntity(), nil
+}
+
 func (r *Repository) GetAccountPassword(ctx context.Context, accountID uuid.UUID) (entity.AccountPassword, error) {
 	acc, err := r.sql.passwords.New().FilterAccountID(accountID).Get(ctx)
 	switch {
@@ -0,0 +1,79 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+func (r *Repository) CreateAccountEmailChange(
+	ctx context.Context,
+	accountID uuid.UUID,
+	newEmail string,
+	expiresAt time.Time,
+) (entity.AccountEmailChange, error) {
+	row := pgdb.AccountEmailChange{
+		AccountID: accountID,
+		NewEmail:  newEmail,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err := r.sql.emailChanges.Transaction(ctx, func(ctx context.Context) error {
+		err := r.sql.emailChanges.New().FilterAccountID(accountID).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		return r.sql.emailChanges.Insert(ctx, row)
+	})
+	if err != nil {
+		return entity.AccountEmailChange{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *Repository) GetAccountEmailChange(ctx context.Context, accountID uuid.UUID) (entity.AccountEmailChange, error) {
+	row, err := r.sql.emailChanges.New().FilterAccountID(accountID).Get(ctx)
+	if err != nil {
+		return entity.AccountEmailChange{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *Repository) ConfirmAccountEmailChange(
+	ctx context.Context,
+	accountID uuid.UUID,
+	newEmail string,
+) (entity.AccountEmail, error) {
+	var email entity.AccountEmail
+
+	err := r.sql.emailChanges.Transaction(ctx, func(ctx context.Context) error {
+		emails, err := r.sql.emails.New().
+			FilterAccountID(accountID).
+			UpdateEmail(newEmail, time.Now().UTC()).
+			UpdateVerified(true).
+			Update(ctx)
+		if err != nil {
+			return err
+		}
+		if len(emails) != 1 {
+			return fmt.Errorf("expected to update 1 account email, updated %d", len(emails))
+		}
+
+		email = emails[0].ToEntity()
+
+		return r.sql.emailChanges.New().FilterAccountID(accountID).Delete(ctx)
+	})
+	if err != nil {
+		return entity.AccountEmail{}, err
+	}
+
+	return email, nil
+}
@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+func (r *Repository) CreateAccountIdentity(
+	ctx context.Context,
+	accountID uuid.UUID,
+	identity entity.SocialIdentity,
+) (entity.AccountIdentity, error) {
+	row := pgdb.AccountIdentity{
+		ID:        uuid.New(),
+		AccountID: accountID,
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		Email:     identity.Email,
+		LinkedAt:  time.Now().UTC(),
+	}
+
+	if err := r.sql.identities.Insert(ctx, row); err != nil {
+		return entity.AccountIdentity{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *Repository) GetAccountIdentityBySubject(
+	ctx context.Context,
+	provider, subject string,
+) (entity.AccountIdentity, error) {
+	row, err := r.sql.identities.New().FilterProvider(provider).FilterSubject(subject).Get(ctx)
+	if err != nil {
+		return entity.AccountIdentity{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *Repository) GetAccountIdentities(ctx context.Context, accountID uuid.UUID) ([]entity.AccountIdentity, error) {
+	rows, err := r.sql.identities.New().FilterAccountID(accountID).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	identities := make([]entity.AccountIdentity, 0, len(rows))
+	for _, row := range rows {
+		identities = append(identities, row.ToEntity())
+	}
+
+	return identities, nil
+}
+
+func (r *Repository) GetAccountIdentity(ctx context.Context, accountID, identityID uuid.UUID) (entity.AccountIdentity, error) {
+	row, err := r.sql.identities.New().FilterAccountID(accountID).FilterID(identityID).Get(ctx)
+	if err != nil {
+		return entity.AccountIdentity{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *Repository) DeleteAccountIdentity(ctx context.Context, accountID, identityID uuid.UUID) error {
+	return r.sql.identities.New().FilterAccountID(accountID).FilterID(identityID).Delete(ctx)
+}
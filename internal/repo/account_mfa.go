@@ -0,0 +1,145 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+func (r *Repository) GetAccountMFA(ctx context.Context, accountID uuid.UUID) (entity.AccountMFA, error) {
+	row, err := r.sql.mfa.New().FilterAccountID(accountID).Get(ctx)
+	if err != nil {
+		return entity.AccountMFA{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *Repository) CreateAccountMFA(
+	ctx context.Context,
+	accountID uuid.UUID,
+	totpSecret string,
+) (entity.AccountMFA, error) {
+	now := time.Now().UTC()
+	row := pgdb.AccountMFA{
+		AccountID:  accountID,
+		TOTPSecret: totpSecret,
+		Enabled:    false,
+		UpdatedAt:  now,
+		CreatedAt:  now,
+	}
+
+	err := r.sql.mfa.Transaction(ctx, func(ctx context.Context) error {
+		err := r.sql.mfa.New().FilterAccountID(accountID).FilterEnabled(false).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		return r.sql.mfa.Insert(ctx, row)
+	})
+	if err != nil {
+		return entity.AccountMFA{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *Repository) EnableAccountMFA(
+	ctx context.Context,
+	accountID uuid.UUID,
+	totpStep int64,
+	recoveryCodeHashes []string,
+) (entity.AccountMFA, error) {
+	var mfa entity.AccountMFA
+
+	err := r.sql.mfa.Transaction(ctx, func(ctx context.Context) error {
+		rows, err := r.sql.mfa.New().
+			FilterAccountID(accountID).
+			FilterEnabled(false).
+			UpdateEnabled(true).
+			UpdateTOTPLastStep(totpStep).
+			Update(ctx)
+		if err != nil {
+			return err
+		}
+		if len(rows) != 1 {
+			return fmt.Errorf("expected to enable 1 account mfa, updated %d", len(rows))
+		}
+
+		mfa = rows[0].ToEntity()
+
+		return r.replaceMFARecoveryCodes(ctx, accountID, recoveryCodeHashes)
+	})
+	if err != nil {
+		return entity.AccountMFA{}, err
+	}
+
+	return mfa, nil
+}
+
+// UseAccountMFATOTPStep records step as the last accepted TOTP step and reports
+// false if the same or a later step has already been used.
+func (r *Repository) UseAccountMFATOTPStep(ctx context.Context, accountID uuid.UUID, step int64) (bool, error) {
+	rows, err := r.sql.mfa.New().
+		FilterAccountID(accountID).
+		FilterEnabled(true).
+		FilterTOTPStepBefore(step).
+		UpdateTOTPLastStep(step).
+		Update(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return len(rows) == 1, nil
+}
+
+func (r *Repository) UseAccountMFARecoveryCode(ctx context.Context, accountID uuid.UUID, codeHash string) (bool, error) {
+	rows, err := r.sql.mfaRecoveryCodes.New().
+		FilterAccountID(accountID).
+		FilterCodeHash(codeHash).
+		FilterUnused().
+		UpdateUsedAt(time.Now().UTC()).
+		Update(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return len(rows) == 1, nil
+}
+
+func (r *Repository) DeleteAccountMFA(ctx context.Context, accountID uuid.UUID) error {
+	return r.sql.mfa.Transaction(ctx, func(ctx context.Context) error {
+		err := r.sql.mfaRecoveryCodes.New().FilterAccountID(accountID).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		return r.sql.mfa.New().FilterAccountID(accountID).Delete(ctx)
+	})
+}
+
+func (r *Repository) replaceMFARecoveryCodes(ctx context.Context, accountID uuid.UUID, codeHashes []string) error {
+	err := r.sql.mfaRecoveryCodes.New().FilterAccountID(accountID).Delete(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, hash := range codeHashes {
+		err = r.sql.mfaRecoveryCodes.Insert(ctx, pgdb.AccountMFARecoveryCode{
+			ID:        uuid.New(),
+			AccountID: accountID,
+			CodeHash:  hash,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
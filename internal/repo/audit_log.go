@@ -0,0 +1,65 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+func (r *Repository) CreateAuditLogEntry(ctx context.Context, entry entity.AuditLogEntry) error {
+	return r.sql.auditLog.Insert(ctx, pgdb.AuditLogEntry{
+		ID:        entry.ID,
+		ActorID:   uuid.NullUUID{UUID: entry.ActorID, Valid: entry.ActorID != uuid.Nil},
+		TargetID:  uuid.NullUUID{UUID: entry.TargetID, Valid: entry.TargetID != uuid.Nil},
+		Action:    entry.Action,
+		IP:        entry.IP,
+		UserAgent: entry.UserAgent,
+		Metadata:  entry.Metadata,
+		CreatedAt: entry.CreatedAt,
+	})
+}
+
+func (r *Repository) GetAuditLog(
+	ctx context.Context,
+	filter auth.AuditLogFilter,
+	cursor uuid.UUID,
+	limit uint64,
+) ([]entity.AuditLogEntry, error) {
+	query := r.sql.auditLog.New()
+	if filter.ActorID != uuid.Nil {
+		query = query.FilterActorID(filter.ActorID)
+	}
+	if filter.TargetID != uuid.Nil {
+		query = query.FilterTargetID(filter.TargetID)
+	}
+	if filter.AccountID != uuid.Nil {
+		query = query.FilterActorOrTargetID(filter.AccountID)
+	}
+	if filter.Action != "" {
+		query = query.FilterAction(filter.Action)
+	}
+	if filter.CreatedAfter != nil {
+		query = query.FilterCreatedAfter(*filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.FilterCreatedBefore(*filter.CreatedBefore)
+	}
+	if cursor != uuid.Nil {
+		query = query.FilterAfterCursor(cursor)
+	}
+
+	rows, err := query.OrderNewestFirst().Limit(limit).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]entity.AuditLogEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, row.ToEntity())
+	}
+
+	return entries, nil
+}
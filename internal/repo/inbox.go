@@ -0,0 +1,124 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/events/consumer"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+// CreateInboxEvent stores a received event, an event with an id already stored is
+// skipped.
+func (r *Repository) CreateInboxEvent(ctx context.Context, event consumer.InboxEvent) error {
+	return r.sql.inbox.New().OnConflictDoNothing().Insert(ctx, pgdb.InboxEvent{
+		ID:        event.ID,
+		Topic:     event.Topic,
+		Key:       event.Key,
+		Type:      event.Type,
+		Version:   event.Version,
+		Producer:  event.Producer,
+		Payload:   event.Payload,
+		Status:    event.Status,
+		Attempts:  event.Attempts,
+		CreatedAt: event.CreatedAt,
+	})
+}
+
+// GetPendingInboxEvents returns up to limit pending events whose retry delay has passed,
+// the oldest first.
+func (r *Repository) GetPendingInboxEvents(ctx context.Context, limit int32) ([]consumer.InboxEvent, error) {
+	rows, err := r.sql.inbox.New().
+		FilterStatus(consumer.InboxStatusPending).
+		FilterReady(time.Now().UTC()).
+		OrderCreatedAt(true).
+		Limit(uint64(limit)).
+		Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]consumer.InboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, toInboxEvent(row))
+	}
+
+	return events, nil
+}
+
+// ClaimInboxEvent locks the event until the end of the transaction if it is still
+// pending and not locked by another consumer, otherwise it returns a nil event. Call it
+// within Transaction.
+func (r *Repository) ClaimInboxEvent(ctx context.Context, id uuid.UUID) (consumer.InboxEvent, error) {
+	row, err := r.sql.inbox.New().
+		FilterID(id).
+		FilterStatus(consumer.InboxStatusPending).
+		ForUpdateSkipLocked().
+		Get(ctx)
+	if err != nil {
+		return consumer.InboxEvent{}, err
+	}
+	if row.ID == uuid.Nil {
+		return consumer.InboxEvent{}, nil
+	}
+
+	return toInboxEvent(row), nil
+}
+
+func (r *Repository) MarkInboxEventProcessed(ctx context.Context, id uuid.UUID) error {
+	now := time.Now().UTC()
+
+	_, err := r.sql.inbox.New().
+		FilterID(id).
+		UpdateStatus(consumer.InboxStatusProcessed).
+		IncrementAttempts().
+		UpdateProcessedAt(&now).
+		UpdateNextRetryAt(nil).
+		Update(ctx)
+
+	return err
+}
+
+// MarkInboxEventAsPending records a failed attempt, the event is handled again once delay
+// has passed.
+func (r *Repository) MarkInboxEventAsPending(ctx context.Context, id uuid.UUID, delay time.Duration) error {
+	nextRetryAt := time.Now().UTC().Add(delay)
+
+	_, err := r.sql.inbox.New().
+		FilterID(id).
+		UpdateStatus(consumer.InboxStatusPending).
+		IncrementAttempts().
+		UpdateNextRetryAt(&nextRetryAt).
+		Update(ctx)
+
+	return err
+}
+
+func (r *Repository) MarkInboxEventAsFailed(ctx context.Context, id uuid.UUID) error {
+	_, err := r.sql.inbox.New().
+		FilterID(id).
+		UpdateStatus(consumer.InboxStatusFailed).
+		IncrementAttempts().
+		UpdateNextRetryAt(nil).
+		Update(ctx)
+
+	return err
+}
+
+func toInboxEvent(row pgdb.InboxEvent) consumer.InboxEvent {
+	return consumer.InboxEvent{
+		ID:          row.ID,
+		Topic:       row.Topic,
+		Key:         row.Key,
+		Type:        row.Type,
+		Version:     row.Version,
+		Producer:    row.Producer,
+		Payload:     row.Payload,
+		Status:      row.Status,
+		Attempts:    row.Attempts,
+		CreatedAt:   row.CreatedAt,
+		NextRetryAt: row.NextRetryAt,
+		ProcessedAt: row.ProcessedAt,
+	}
+}
@@ -0,0 +1,75 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+func (r *Repository) GetLoginThrottle(ctx context.Context, kind, subject string) (entity.LoginThrottle, error) {
+	row, err := r.sql.loginThrottles.New().FilterKind(kind).FilterSubject(subject).Get(ctx)
+	if err != nil {
+		return entity.LoginThrottle{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+// RegisterLoginFailure counts a failed attempt for the subject under a row lock, so
+// concurrent failures are never lost, and returns the resulting throttle state.
+func (r *Repository) RegisterLoginFailure(
+	ctx context.Context,
+	kind, subject string,
+	policy entity.LoginLockoutPolicy,
+) (entity.LoginThrottle, error) {
+	var throttle entity.LoginThrottle
+
+	err := r.sql.loginThrottles.Transaction(ctx, func(ctx context.Context) error {
+		now := time.Now().UTC()
+
+		err := r.sql.loginThrottles.New().OnConflictDoNothing().Insert(ctx, pgdb.LoginThrottle{
+			Kind:         kind,
+			Subject:      subject,
+			LastFailedAt: now,
+		})
+		if err != nil {
+			return err
+		}
+
+		row, err := r.sql.loginThrottles.New().FilterKind(kind).FilterSubject(subject).ForUpdate().Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		throttle = row.ToEntity().RegisterFailure(now, policy)
+
+		rows, err := r.sql.loginThrottles.New().
+			FilterKind(kind).
+			FilterSubject(subject).
+			UpdateFailedAttempts(throttle.FailedAttempts).
+			UpdateLockouts(throttle.Lockouts).
+			UpdateLockedUntil(throttle.LockedUntil).
+			UpdateLastFailedAt(throttle.LastFailedAt).
+			Update(ctx)
+		if err != nil {
+			return err
+		}
+		if len(rows) != 1 {
+			return fmt.Errorf("expected to update 1 login throttle, updated %d", len(rows))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.LoginThrottle{}, err
+	}
+
+	return throttle, nil
+}
+
+func (r *Repository) ResetLoginThrottle(ctx context.Context, kind, subject string) error {
+	return r.sql.loginThrottles.New().FilterKind(kind).FilterSubject(subject).Delete(ctx)
+}
@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+// CreateMFAChallenge stores the challenge and drops the expired ones on the way.
+func (r *Repository) CreateMFAChallenge(
+	ctx context.Context,
+	challengeID, accountID uuid.UUID,
+	expiresAt time.Time,
+) (entity.MFAChallenge, error) {
+	row := pgdb.MFAChallenge{
+		ID:        challengeID,
+		AccountID: accountID,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err := r.sql.mfaChallenges.Transaction(ctx, func(ctx context.Context) error {
+		err := r.sql.mfaChallenges.New().FilterExpiredBefore(row.CreatedAt).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		return r.sql.mfaChallenges.Insert(ctx, row)
+	})
+	if err != nil {
+		return entity.MFAChallenge{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+// CountMFAChallengeAttempt counts one more code checked against the challenge. It returns
+// a nil challenge when there is no such challenge or it already ran out of maxAttempts.
+func (r *Repository) CountMFAChallengeAttempt(
+	ctx context.Context,
+	challengeID uuid.UUID,
+	maxAttempts int32,
+) (entity.MFAChallenge, error) {
+	rows, err := r.sql.mfaChallenges.New().
+		FilterID(challengeID).
+		FilterAttemptsBelow(maxAttempts).
+		IncrementAttempts().
+		Update(ctx)
+	if err != nil {
+		return entity.MFAChallenge{}, err
+	}
+	if len(rows) != 1 {
+		return entity.MFAChallenge{}, nil
+	}
+
+	return rows[0].ToEntity(), nil
+}
+
+// ConsumeMFAChallenge returns the challenge and deletes it, so every challenge opens one
+// session only.
+func (r *Repository) ConsumeMFAChallenge(ctx context.Context, challengeID uuid.UUID) (entity.MFAChallenge, error) {
+	var challenge entity.MFAChallenge
+
+	err := r.sql.mfaChallenges.Transaction(ctx, func(ctx context.Context) error {
+		row, err := r.sql.mfaChallenges.New().FilterID(challengeID).ForUpdate().Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		challenge = row.ToEntity()
+
+		return r.sql.mfaChallenges.New().FilterID(challengeID).Delete(ctx)
+	})
+	if err != nil {
+		return entity.MFAChallenge{}, err
+	}
+
+	return challenge, nil
+}
@@ -0,0 +1,139 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+func (r *Repository) CreateOAuthClient(ctx context.Context, client entity.OAuthClient) (entity.OAuthClient, error) {
+	now := time.Now().UTC()
+
+	row := pgdb.OAuthClient{
+		ID:           client.ID,
+		Name:         client.Name,
+		RedirectURIs: client.RedirectURIs,
+		Scopes:       client.Scopes,
+		SkipConsent:  client.SkipConsent,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if !client.IsPublic() {
+		row.SecretHash = &client.SecretHash
+	}
+
+	if err := r.sql.oauthClients.Insert(ctx, row); err != nil {
+		return entity.OAuthClient{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *Repository) GetOAuthClient(ctx context.Context, clientID string) (entity.OAuthClient, error) {
+	row, err := r.sql.oauthClients.New().FilterID(clientID).Get(ctx)
+	if err != nil {
+		return entity.OAuthClient{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+// CreateOAuthAuthorizationCode stores the code and drops the expired ones on the way.
+func (r *Repository) CreateOAuthAuthorizationCode(
+	ctx context.Context,
+	code entity.OAuthAuthorizationCode,
+) (entity.OAuthAuthorizationCode, error) {
+	row := pgdb.OAuthAuthorizationCode{
+		CodeHash:            code.CodeHash,
+		ClientID:            code.ClientID,
+		AccountID:           code.AccountID,
+		RedirectURI:         code.RedirectURI,
+		Scopes:              code.Scopes,
+		Nonce:               code.Nonce,
+		CodeChallenge:       code.CodeChallenge,
+		CodeChallengeMethod: code.CodeChallengeMethod,
+		AuthTime:            code.AuthTime,
+		ExpiresAt:           code.ExpiresAt,
+		CreatedAt:           time.Now().UTC(),
+	}
+
+	err := r.sql.oauthCodes.Transaction(ctx, func(ctx context.Context) error {
+		err := r.sql.oauthCodes.New().FilterExpiredBefore(row.CreatedAt).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		return r.sql.oauthCodes.Insert(ctx, row)
+	})
+	if err != nil {
+		return entity.OAuthAuthorizationCode{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+// ConsumeOAuthAuthorizationCode returns the code and deletes it, so every code can be
+// exchanged only once.
+func (r *Repository) ConsumeOAuthAuthorizationCode(
+	ctx context.Context,
+	codeHash string,
+) (entity.OAuthAuthorizationCode, error) {
+	var code entity.OAuthAuthorizationCode
+
+	err := r.sql.oauthCodes.Transaction(ctx, func(ctx context.Context) error {
+		row, err := r.sql.oauthCodes.New().FilterCodeHash(codeHash).ForUpdate().Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		code = row.ToEntity()
+
+		return r.sql.oauthCodes.New().FilterCodeHash(codeHash).Delete(ctx)
+	})
+	if err != nil {
+		return entity.OAuthAuthorizationCode{}, err
+	}
+
+	return code, nil
+}
+
+func (r *Repository) GetOAuthConsent(
+	ctx context.Context,
+	accountID uuid.UUID,
+	clientID string,
+) (entity.OAuthConsent, error) {
+	row, err := r.sql.oauthConsents.New().FilterAccountID(accountID).FilterClientID(clientID).Get(ctx)
+	if err != nil {
+		return entity.OAuthConsent{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+// SaveOAuthConsent creates the consent of the account for the client or replaces the
+// scopes of the existing one.
+func (r *Repository) SaveOAuthConsent(
+	ctx context.Context,
+	accountID uuid.UUID,
+	clientID string,
+	scopes []string,
+) (entity.OAuthConsent, error) {
+	now := time.Now().UTC()
+
+	row := pgdb.OAuthConsent{
+		AccountID: accountID,
+		ClientID:  clientID,
+		Scopes:    scopes,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := r.sql.oauthConsents.New().OnConflictUpdateScopes().Insert(ctx, row); err != nil {
+		return entity.OAuthConsent{}, err
+	}
+
+	return row.ToEntity(), nil
+}
@@ -0,0 +1,344 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/umisto/kafkakit/box"
+	"github.com/umisto/kafkakit/header"
+	"github.com/umisto/sso-svc/internal/events/producer"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+// CreateOutboxEvent stores the message for the producer to send. Called within
+// Transaction the event commits or rolls back with the changes it announces.
+func (r *Repository) CreateOutboxEvent(
+	ctx context.Context,
+	status string,
+	message kafka.Message,
+) (box.OutboxEvent, error) {
+	row := pgdb.OutboxEvent{
+		ID:        uuid.New(),
+		Topic:     message.Topic,
+		Key:       string(message.Key),
+		Payload:   message.Value,
+		Status:    status,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	for _, h := range message.Headers {
+		switch h.Key {
+		case header.EventID:
+			if id, err := uuid.ParseBytes(h.Value); err == nil {
+				row.ID = id
+			}
+		case header.EventType:
+			row.Type = string(h.Value)
+		case header.EventVersion:
+			version, err := strconv.ParseInt(string(h.Value), 10, 32)
+			if err != nil {
+				return box.OutboxEvent{}, fmt.Errorf("parsing event version %q: %w", h.Value, err)
+			}
+			row.Version = int32(version)
+		case header.Producer:
+			row.Producer = string(h.Value)
+		}
+	}
+
+	if err := r.sql.outbox.Insert(ctx, row); err != nil {
+		return box.OutboxEvent{}, err
+	}
+
+	return toOutboxEvent(row), nil
+}
+
+func (r *Repository) GetOutboxEventByID(ctx context.Context, id uuid.UUID) (box.OutboxEvent, error) {
+	row, err := r.sql.outbox.New().FilterID(id).Get(ctx)
+	if err != nil {
+		return box.OutboxEvent{}, err
+	}
+	if row.ID == uuid.Nil {
+		return box.OutboxEvent{}, nil
+	}
+
+	return toOutboxEvent(row), nil
+}
+
+// ClaimOutboxEvents moves up to limit pending events whose retry delay has passed into
+// processing, the oldest first, and leases them to the caller for lease. Rows claimed by
+// another producer at the same time are skipped, so every event goes to one producer.
+// Only the holder of the returned claim can mark the events afterwards.
+func (r *Repository) ClaimOutboxEvents(
+	ctx context.Context,
+	limit int32,
+	lease time.Duration,
+) (producer.OutboxClaim, error) {
+	claimID := uuid.New()
+
+	var rows []pgdb.OutboxEvent
+	err := r.sql.outbox.Transaction(ctx, func(ctx context.Context) error {
+		now := time.Now().UTC()
+
+		pending, err := r.sql.outbox.New().
+			FilterStatus(box.OutboxStatusPending).
+			FilterReady(now).
+			OrderCreatedAt(true).
+			Limit(uint64(limit)).
+			ForUpdateSkipLocked().
+			Select(ctx)
+		if err != nil || len(pending) == 0 {
+			return err
+		}
+
+		ids := make([]uuid.UUID, 0, len(pending))
+		for _, row := range pending {
+			ids = append(ids, row.ID)
+		}
+
+		lockedUntil := now.Add(lease)
+		rows, err = r.sql.outbox.New().
+			FilterIDs(ids).
+			UpdateStatus(box.OutboxStatusProcessing).
+			UpdateLockedUntil(&lockedUntil).
+			UpdateClaimID(uuid.NullUUID{UUID: claimID, Valid: true}).
+			Update(ctx)
+
+		return err
+	})
+	if err != nil {
+		return producer.OutboxClaim{}, err
+	}
+
+	return producer.OutboxClaim{
+		ID:     claimID,
+		Events: toOutboxEvents(rows),
+	}, nil
+}
+
+// RecoverOutboxEvents puts the events whose lease has run out back in the queue, their
+// producer stopped before it could mark them. It returns the recovered events.
+func (r *Repository) RecoverOutboxEvents(ctx context.Context) ([]box.OutboxEvent, error) {
+	rows, err := r.sql.outbox.New().
+		FilterStatus(box.OutboxStatusProcessing).
+		FilterLeaseExpired(time.Now().UTC()).
+		UpdateStatus(box.OutboxStatusPending).
+		UpdateLockedUntil(nil).
+		UpdateClaimID(uuid.NullUUID{}).
+		Update(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOutboxEvents(rows), nil
+}
+
+// MarkOutboxEventsSent marks the events of the claim sent. Events the claim no longer
+// holds, recovered after its lease ran out, are left alone and not returned.
+func (r *Repository) MarkOutboxEventsSent(
+	ctx context.Context,
+	claimID uuid.UUID,
+	ids []uuid.UUID,
+) ([]box.OutboxEvent, error) {
+	now := time.Now().UTC()
+
+	rows, err := r.sql.outbox.New().
+		FilterIDs(ids).
+		FilterStatus(box.OutboxStatusProcessing).
+		FilterClaimID(claimID).
+		UpdateStatus(box.OutboxStatusSent).
+		UpdateSentAt(&now).
+		UpdateNextRetryAt(nil).
+		UpdateLockedUntil(nil).
+		UpdateClaimID(uuid.NullUUID{}).
+		Update(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOutboxEvents(rows), nil
+}
+
+// MarkOutboxEventsAsFailed gives up on the events of the claim, like MarkOutboxEventsSent
+// it leaves the events the claim no longer holds alone.
+func (r *Repository) MarkOutboxEventsAsFailed(
+	ctx context.Context,
+	claimID uuid.UUID,
+	ids []uuid.UUID,
+) ([]box.OutboxEvent, error) {
+	rows, err := r.sql.outbox.New().
+		FilterIDs(ids).
+		FilterStatus(box.OutboxStatusProcessing).
+		FilterClaimID(claimID).
+		UpdateStatus(box.OutboxStatusFailed).
+		IncrementAttempts().
+		UpdateNextRetryAt(nil).
+		UpdateLockedUntil(nil).
+		UpdateClaimID(uuid.NullUUID{}).
+		Update(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOutboxEvents(rows), nil
+}
+
+// MarkOutboxEventsAsPending puts the events of the claim back in the queue after a failed
+// attempt, the producer picks them up again once delay has passed. Like
+// MarkOutboxEventsSent it leaves the events the claim no longer holds alone.
+func (r *Repository) MarkOutboxEventsAsPending(
+	ctx context.Context,
+	claimID uuid.UUID,
+	ids []uuid.UUID,
+	delay time.Duration,
+) ([]box.OutboxEvent, error) {
+	nextRetryAt := time.Now().UTC().Add(delay)
+
+	rows, err := r.sql.outbox.New().
+		FilterIDs(ids).
+		FilterStatus(box.OutboxStatusProcessing).
+		FilterClaimID(claimID).
+		UpdateStatus(box.OutboxStatusPending).
+		IncrementAttempts().
+		UpdateNextRetryAt(&nextRetryAt).
+		UpdateLockedUntil(nil).
+		UpdateClaimID(uuid.NullUUID{}).
+		Update(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOutboxEvents(rows), nil
+}
+
+func (r *Repository) GetFailedOutboxEvents(ctx context.Context, limit int32) ([]box.OutboxEvent, error) {
+	rows, err := r.sql.outbox.New().
+		FilterStatus(box.OutboxStatusFailed).
+		OrderCreatedAt(true).
+		Limit(uint64(limit)).
+		Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOutboxEvents(rows), nil
+}
+
+// ReplayFailedOutboxEvents puts failed events back in the queue with their attempts
+// reset, every failed event when ids is empty. It returns the replayed events.
+func (r *Repository) ReplayFailedOutboxEvents(ctx context.Context, ids []uuid.UUID) ([]box.OutboxEvent, error) {
+	q := r.sql.outbox.New().FilterStatus(box.OutboxStatusFailed)
+	if len(ids) > 0 {
+		q = q.FilterIDs(ids)
+	}
+
+	rows, err := q.
+		UpdateStatus(box.OutboxStatusPending).
+		UpdateAttempts(0).
+		UpdateNextRetryAt(nil).
+		Update(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOutboxEvents(rows), nil
+}
+
+// PurgeFailedOutboxEvents deletes failed events, the given ones or, when ids is empty,
+// every one created before the given time. It returns how many were deleted.
+func (r *Repository) PurgeFailedOutboxEvents(
+	ctx context.Context,
+	ids []uuid.UUID,
+	createdBefore time.Time,
+) (int64, error) {
+	q := r.sql.outbox.New().FilterStatus(box.OutboxStatusFailed)
+	if len(ids) > 0 {
+		q = q.FilterIDs(ids)
+	} else {
+		q = q.FilterCreatedBefore(createdBefore)
+	}
+
+	var total uint64
+	err := r.sql.outbox.Transaction(ctx, func(ctx context.Context) (err error) {
+		if total, err = q.Count(ctx); err != nil {
+			return err
+		}
+
+		return q.Delete(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(total), nil
+}
+
+// GetOutboxStats counts the events waiting to be sent, those being sent and those given
+// up on, and finds the oldest one not sent yet.
+func (r *Repository) GetOutboxStats(ctx context.Context) (producer.OutboxStats, error) {
+	pending, err := r.sql.outbox.New().FilterStatus(box.OutboxStatusPending).Count(ctx)
+	if err != nil {
+		return producer.OutboxStats{}, err
+	}
+
+	processing, err := r.sql.outbox.New().FilterStatus(box.OutboxStatusProcessing).Count(ctx)
+	if err != nil {
+		return producer.OutboxStats{}, err
+	}
+
+	failed, err := r.sql.outbox.New().FilterStatus(box.OutboxStatusFailed).Count(ctx)
+	if err != nil {
+		return producer.OutboxStats{}, err
+	}
+
+	stats := producer.OutboxStats{
+		Pending:    int64(pending),
+		Processing: int64(processing),
+		Failed:     int64(failed),
+	}
+	if pending+processing == 0 {
+		return stats, nil
+	}
+
+	oldest, err := r.sql.outbox.New().
+		FilterStatuses([]string{box.OutboxStatusPending, box.OutboxStatusProcessing}).
+		OrderCreatedAt(true).
+		Get(ctx)
+	if err != nil {
+		return producer.OutboxStats{}, err
+	}
+	if oldest.ID != uuid.Nil {
+		stats.OldestPendingAt = &oldest.CreatedAt
+	}
+
+	return stats, nil
+}
+
+func toOutboxEvent(row pgdb.OutboxEvent) box.OutboxEvent {
+	return box.OutboxEvent{
+		ID:          row.ID,
+		Topic:       row.Topic,
+		Key:         row.Key,
+		Type:        row.Type,
+		Version:     row.Version,
+		Producer:    row.Producer,
+		Payload:     row.Payload,
+		Status:      row.Status,
+		Attempts:    row.Attempts,
+		CreatedAt:   row.CreatedAt,
+		NextRetryAt: row.NextRetryAt,
+		SentAt:      row.SentAt,
+	}
+}
+
+func toOutboxEvents(rows []pgdb.OutboxEvent) []box.OutboxEvent {
+	events := make([]box.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, toOutboxEvent(row))
+	}
+
+	return events
+}
@@ -0,0 +1,138 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+func (r *Repository) CreatePasskey(ctx context.Context, passkey entity.Passkey) (entity.Passkey, error) {
+	row := pgdb.WebAuthnCredential{
+		ID:              uuid.New(),
+		AccountID:       passkey.AccountID,
+		CredentialID:    passkey.CredentialID,
+		PublicKey:       passkey.PublicKey,
+		AttestationType: passkey.AttestationType,
+		Transports:      passkey.Transports,
+		SignCount:       int64(passkey.SignCount),
+		AAGUID:          passkey.AAGUID,
+		BackupEligible:  passkey.BackupEligible,
+		BackupState:     passkey.BackupState,
+		Name:            passkey.Name,
+		CreatedAt:       time.Now().UTC(),
+	}
+
+	if err := r.sql.passkeys.Insert(ctx, row); err != nil {
+		return entity.Passkey{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *Repository) GetAccountPasskeys(ctx context.Context, accountID uuid.UUID) ([]entity.Passkey, error) {
+	rows, err := r.sql.passkeys.New().FilterAccountID(accountID).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys := make([]entity.Passkey, 0, len(rows))
+	for _, row := range rows {
+		passkeys = append(passkeys, row.ToEntity())
+	}
+
+	return passkeys, nil
+}
+
+func (r *Repository) GetAccountPasskey(ctx context.Context, accountID, passkeyID uuid.UUID) (entity.Passkey, error) {
+	row, err := r.sql.passkeys.New().FilterAccountID(accountID).FilterID(passkeyID).Get(ctx)
+	if err != nil {
+		return entity.Passkey{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *Repository) UpdatePasskeyUsage(
+	ctx context.Context,
+	passkeyID uuid.UUID,
+	signCount uint32,
+	backupState bool,
+) (entity.Passkey, error) {
+	rows, err := r.sql.passkeys.New().
+		FilterID(passkeyID).
+		UpdateSignCount(int64(signCount)).
+		UpdateBackupState(backupState).
+		UpdateLastUsedAt(time.Now().UTC()).
+		Update(ctx)
+	if err != nil {
+		return entity.Passkey{}, err
+	}
+	if len(rows) != 1 {
+		return entity.Passkey{}, fmt.Errorf("expected to update 1 passkey, updated %d", len(rows))
+	}
+
+	return rows[0].ToEntity(), nil
+}
+
+func (r *Repository) DeleteAccountPasskey(ctx context.Context, accountID, passkeyID uuid.UUID) error {
+	return r.sql.passkeys.New().FilterAccountID(accountID).FilterID(passkeyID).Delete(ctx)
+}
+
+// CreatePasskeyCeremony stores the state of a started ceremony. accountID is uuid.Nil for
+// a login, where the account is not known until the assertion is verified.
+func (r *Repository) CreatePasskeyCeremony(
+	ctx context.Context,
+	accountID uuid.UUID,
+	kind string,
+	session []byte,
+	expiresAt time.Time,
+) (entity.PasskeyCeremony, error) {
+	row := pgdb.WebAuthnSession{
+		ID:        uuid.New(),
+		AccountID: uuid.NullUUID{UUID: accountID, Valid: accountID != uuid.Nil},
+		Kind:      kind,
+		Data:      session,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err := r.sql.passkeySessions.Transaction(ctx, func(ctx context.Context) error {
+		err := r.sql.passkeySessions.New().FilterExpiredBefore(row.CreatedAt).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		return r.sql.passkeySessions.Insert(ctx, row)
+	})
+	if err != nil {
+		return entity.PasskeyCeremony{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+// ConsumePasskeyCeremony returns the ceremony and deletes it, so every challenge can be
+// answered only once.
+func (r *Repository) ConsumePasskeyCeremony(ctx context.Context, ceremonyID uuid.UUID) (entity.PasskeyCeremony, error) {
+	var ceremony entity.PasskeyCeremony
+
+	err := r.sql.passkeySessions.Transaction(ctx, func(ctx context.Context) error {
+		row, err := r.sql.passkeySessions.New().FilterID(ceremonyID).ForUpdate().Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		ceremony = row.ToEntity()
+
+		return r.sql.passkeySessions.New().FilterID(ceremonyID).Delete(ctx)
+	})
+	if err != nil {
+		return entity.PasskeyCeremony{}, err
+	}
+
+	return ceremony, nil
+}
@@ -0,0 +1,98 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+func (r *Repository) CreatePasswordResetToken(
+	ctx context.Context,
+	accountID uuid.UUID,
+	tokenHash string,
+	expiresAt time.Time,
+) (entity.PasswordResetToken, error) {
+	row := pgdb.PasswordResetToken{
+		ID:        uuid.New(),
+		AccountID: accountID,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err := r.sql.passwordResetTokens.Transaction(ctx, func(ctx context.Context) error {
+		err := r.sql.passwordResetTokens.New().
+			FilterAccountID(accountID).
+			FilterUnused().
+			Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		return r.sql.passwordResetTokens.Insert(ctx, row)
+	})
+	if err != nil {
+		return entity.PasswordResetToken{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *Repository) GetPasswordResetToken(ctx context.Context, tokenHash string) (entity.PasswordResetToken, error) {
+	row, err := r.sql.passwordResetTokens.New().FilterTokenHash(tokenHash).Get(ctx)
+	if err != nil {
+		return entity.PasswordResetToken{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+func (r *Repository) ResetAccountPassword(
+	ctx context.Context,
+	tokenID, accountID uuid.UUID,
+	passwordHash string,
+) (entity.AccountPassword, error) {
+	var password entity.AccountPassword
+
+	err := r.sql.passwordResetTokens.Transaction(ctx, func(ctx context.Context) error {
+		tokens, err := r.sql.passwordResetTokens.New().
+			FilterID(tokenID).
+			FilterAccountID(accountID).
+			FilterUnused().
+			UpdateUsedAt(time.Now().UTC()).
+			Update(ctx)
+		if err != nil {
+			return err
+		}
+		if len(tokens) != 1 {
+			return errx.ErrorPasswordResetTokenInvalid.Raise(
+				fmt.Errorf("password reset token %s has already been used", tokenID),
+			)
+		}
+
+		passwords, err := r.sql.passwords.New().
+			FilterAccountID(accountID).
+			UpdateHash(passwordHash).
+			Update(ctx)
+		if err != nil {
+			return err
+		}
+		if len(passwords) != 1 {
+			return fmt.Errorf("expected to update 1 account password, updated %d", len(passwords))
+		}
+
+		password = passwords[0].ToEntity()
+
+		return r.DeleteSessionsForAccount(ctx, accountID)
+	})
+	if err != nil {
+		return entity.AccountPassword{}, err
+	}
+
+	return password, nil
+}
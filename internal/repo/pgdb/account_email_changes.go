@@ -0,0 +1,215 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const accountEmailChangesTable = "account_email_changes"
+
+type AccountEmailChange struct {
+	AccountID uuid.UUID `db:"account_id"`
+	NewEmail  string    `db:"new_email"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type AccountEmailChangesQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewAccountEmailChanges(db *sql.DB) AccountEmailChangesQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return AccountEmailChangesQ{
+		db:       db,
+		selector: builder.Select("account_email_changes.*").From(accountEmailChangesTable),
+		inserter: builder.Insert(accountEmailChangesTable),
+		updater:  builder.Update(accountEmailChangesTable),
+		deleter:  builder.Delete(accountEmailChangesTable),
+		counter:  builder.Select("COUNT(*) AS count").From(accountEmailChangesTable),
+	}
+}
+
+func (q AccountEmailChangesQ) New() AccountEmailChangesQ {
+	return NewAccountEmailChanges(q.db)
+}
+
+func (q AccountEmailChangesQ) Insert(ctx context.Context, input AccountEmailChange) error {
+	values := map[string]interface{}{
+		"account_id": input.AccountID,
+		"new_email":  input.NewEmail,
+		"expires_at": input.ExpiresAt,
+		"created_at": input.CreatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", accountEmailChangesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q AccountEmailChangesQ) Get(ctx context.Context) (AccountEmailChange, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return AccountEmailChange{}, fmt.Errorf("building get query for %s: %w", accountEmailChangesTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var c AccountEmailChange
+	err = row.Scan(
+		&c.AccountID,
+		&c.NewEmail,
+		&c.ExpiresAt,
+		&c.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AccountEmailChange{}, nil
+		}
+		return AccountEmailChange{}, err
+	}
+
+	return c, nil
+}
+
+func (q AccountEmailChangesQ) Select(ctx context.Context) ([]AccountEmailChange, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", accountEmailChangesTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []AccountEmailChange
+	for rows.Next() {
+		var c AccountEmailChange
+		err = rows.Scan(
+			&c.AccountID,
+			&c.NewEmail,
+			&c.ExpiresAt,
+			&c.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning account email change: %w", err)
+		}
+		out = append(out, c)
+	}
+
+	return out, nil
+}
+
+func (q AccountEmailChangesQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", accountEmailChangesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q AccountEmailChangesQ) FilterAccountID(accountID uuid.UUID) AccountEmailChangesQ {
+	q.selector = q.selector.Where(sq.Eq{"account_id": accountID})
+	q.counter = q.counter.Where(sq.Eq{"account_id": accountID})
+	q.deleter = q.deleter.Where(sq.Eq{"account_id": accountID})
+	q.updater = q.updater.Where(sq.Eq{"account_id": accountID})
+	return q
+}
+
+func (q AccountEmailChangesQ) FilterNewEmail(email string) AccountEmailChangesQ {
+	q.selector = q.selector.Where(sq.Eq{"new_email": email})
+	q.counter = q.counter.Where(sq.Eq{"new_email": email})
+	q.deleter = q.deleter.Where(sq.Eq{"new_email": email})
+	q.updater = q.updater.Where(sq.Eq{"new_email": email})
+	return q
+}
+
+func (q AccountEmailChangesQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", accountEmailChangesTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q AccountEmailChangesQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
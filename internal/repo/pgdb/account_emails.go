@@ -19,6 +19,9 @@ type AccountEmail struct {
 	Verified  bool      `db:"verified"`
 	UpdatedAt time.Time `db:"updated_at"`
 	CreatedAt time.Time `db:"created_at"`
+
+	VerificationSentAt *time.Time `db:"verification_sent_at"`
+	EmailChangedAt     *time.Time `db:"email_changed_at"`
 }
 
 type AccountEmailsQ struct {
@@ -53,6 +56,9 @@ func (q AccountEmailsQ) Insert(ctx context.Context, input AccountEmail) error {
 		"verified":   input.Verified,
 		"updated_at": input.UpdatedAt,
 		"created_at": input.CreatedAt,
+
+		"verification_sent_at": input.VerificationSentAt,
+		"email_changed_at":     input.EmailChangedAt,
 	}
 
 	query, args, err := q.inserter.SetMap(values).ToSql()
@@ -99,6 +105,8 @@ func (q AccountEmailsQ) Update(ctx context.Context) ([]AccountEmail, error) {
 			&e.Verified,
 			&e.UpdatedAt,
 			&e.CreatedAt,
+			&e.VerificationSentAt,
+			&e.EmailChangedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scanning updated account email: %w", err)
@@ -109,8 +117,10 @@ func (q AccountEmailsQ) Update(ctx context.Context) ([]AccountEmail, error) {
 	return out, nil
 }
 
-func (q AccountEmailsQ) UpdateEmail(email string) AccountEmailsQ {
-	q.updater = q.updater.Set("email", email)
+func (q AccountEmailsQ) UpdateEmail(email string, emailChangedAt time.Time) AccountEmailsQ {
+	q.updater = q.updater.
+		Set("email", email).
+		Set("email_changed_at", emailChangedAt)
 	return q
 }
 
@@ -119,6 +129,11 @@ func (q AccountEmailsQ) UpdateVerified(verified bool) AccountEmailsQ {
 	return q
 }
 
+func (q AccountEmailsQ) UpdateVerificationSentAt(sentAt time.Time) AccountEmailsQ {
+	q.updater = q.updater.Set("verification_sent_at", sentAt)
+	return q
+}
+
 func (q AccountEmailsQ) Get(ctx context.Context) (AccountEmail, error) {
 	query, args, err := q.selector.Limit(1).ToSql()
 	if err != nil {
@@ -139,6 +154,8 @@ func (q AccountEmailsQ) Get(ctx context.Context) (AccountEmail, error) {
 		&e.Verified,
 		&e.UpdatedAt,
 		&e.CreatedAt,
+		&e.VerificationSentAt,
+		&e.EmailChangedAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -176,6 +193,8 @@ func (q AccountEmailsQ) Select(ctx context.Context) ([]AccountEmail, error) {
 			&e.Verified,
 			&e.UpdatedAt,
 			&e.CreatedAt,
+			&e.VerificationSentAt,
+			&e.EmailChangedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scanning account_email: %w", err)
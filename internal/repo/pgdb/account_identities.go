@@ -0,0 +1,239 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const accountIdentitiesTable = "account_identities"
+
+type AccountIdentity struct {
+	ID        uuid.UUID `db:"id"`
+	AccountID uuid.UUID `db:"account_id"`
+	Provider  string    `db:"provider"`
+	Subject   string    `db:"subject"`
+	Email     string    `db:"email"`
+	LinkedAt  time.Time `db:"linked_at"`
+}
+
+type AccountIdentitiesQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewAccountIdentities(db *sql.DB) AccountIdentitiesQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return AccountIdentitiesQ{
+		db:       db,
+		selector: builder.Select("account_identities.*").From(accountIdentitiesTable),
+		inserter: builder.Insert(accountIdentitiesTable),
+		updater:  builder.Update(accountIdentitiesTable),
+		deleter:  builder.Delete(accountIdentitiesTable),
+		counter:  builder.Select("COUNT(*) AS count").From(accountIdentitiesTable),
+	}
+}
+
+func (q AccountIdentitiesQ) New() AccountIdentitiesQ {
+	return NewAccountIdentities(q.db)
+}
+
+func (q AccountIdentitiesQ) Insert(ctx context.Context, input AccountIdentity) error {
+	values := map[string]interface{}{
+		"id":         input.ID,
+		"account_id": input.AccountID,
+		"provider":   input.Provider,
+		"subject":    input.Subject,
+		"email":      input.Email,
+		"linked_at":  input.LinkedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", accountIdentitiesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q AccountIdentitiesQ) Get(ctx context.Context) (AccountIdentity, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return AccountIdentity{}, fmt.Errorf("building get query for %s: %w", accountIdentitiesTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var i AccountIdentity
+	err = row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.LinkedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AccountIdentity{}, nil
+		}
+		return AccountIdentity{}, err
+	}
+
+	return i, nil
+}
+
+func (q AccountIdentitiesQ) Select(ctx context.Context) ([]AccountIdentity, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", accountIdentitiesTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []AccountIdentity
+	for rows.Next() {
+		var i AccountIdentity
+		err = rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Provider,
+			&i.Subject,
+			&i.Email,
+			&i.LinkedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning account identity: %w", err)
+		}
+		out = append(out, i)
+	}
+
+	return out, nil
+}
+
+func (q AccountIdentitiesQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", accountIdentitiesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q AccountIdentitiesQ) FilterID(id uuid.UUID) AccountIdentitiesQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q AccountIdentitiesQ) FilterAccountID(accountID uuid.UUID) AccountIdentitiesQ {
+	q.selector = q.selector.Where(sq.Eq{"account_id": accountID})
+	q.counter = q.counter.Where(sq.Eq{"account_id": accountID})
+	q.deleter = q.deleter.Where(sq.Eq{"account_id": accountID})
+	q.updater = q.updater.Where(sq.Eq{"account_id": accountID})
+	return q
+}
+
+func (q AccountIdentitiesQ) FilterProvider(provider string) AccountIdentitiesQ {
+	q.selector = q.selector.Where(sq.Eq{"provider": provider})
+	q.counter = q.counter.Where(sq.Eq{"provider": provider})
+	q.deleter = q.deleter.Where(sq.Eq{"provider": provider})
+	q.updater = q.updater.Where(sq.Eq{"provider": provider})
+	return q
+}
+
+func (q AccountIdentitiesQ) FilterSubject(subject string) AccountIdentitiesQ {
+	q.selector = q.selector.Where(sq.Eq{"subject": subject})
+	q.counter = q.counter.Where(sq.Eq{"subject": subject})
+	q.deleter = q.deleter.Where(sq.Eq{"subject": subject})
+	q.updater = q.updater.Where(sq.Eq{"subject": subject})
+	return q
+}
+
+func (q AccountIdentitiesQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", accountIdentitiesTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q AccountIdentitiesQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
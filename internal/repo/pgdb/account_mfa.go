@@ -0,0 +1,247 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const accountMFATable = "account_mfa"
+
+type AccountMFA struct {
+	AccountID    uuid.UUID `db:"account_id"`
+	TOTPSecret   string    `db:"totp_secret"`
+	Enabled      bool      `db:"enabled"`
+	TOTPLastStep int64     `db:"totp_last_step"`
+	UpdatedAt    time.Time `db:"updated_at"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+type AccountMFAQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewAccountMFA(db *sql.DB) AccountMFAQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return AccountMFAQ{
+		db:       db,
+		selector: builder.Select("account_mfa.*").From(accountMFATable),
+		inserter: builder.Insert(accountMFATable),
+		updater:  builder.Update(accountMFATable),
+		deleter:  builder.Delete(accountMFATable),
+		counter:  builder.Select("COUNT(*) AS count").From(accountMFATable),
+	}
+}
+
+func (q AccountMFAQ) New() AccountMFAQ {
+	return NewAccountMFA(q.db)
+}
+
+func (q AccountMFAQ) Insert(ctx context.Context, input AccountMFA) error {
+	values := map[string]interface{}{
+		"account_id":     input.AccountID,
+		"totp_secret":    input.TOTPSecret,
+		"enabled":        input.Enabled,
+		"totp_last_step": input.TOTPLastStep,
+		"updated_at":     input.UpdatedAt,
+		"created_at":     input.CreatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", accountMFATable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q AccountMFAQ) Update(ctx context.Context) ([]AccountMFA, error) {
+	q.updater = q.updater.
+		Set("updated_at", time.Now().UTC()).
+		Suffix("RETURNING account_mfa.*")
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update query for %s: %w", accountMFATable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []AccountMFA
+	for rows.Next() {
+		var m AccountMFA
+		err = rows.Scan(
+			&m.AccountID,
+			&m.TOTPSecret,
+			&m.Enabled,
+			&m.TOTPLastStep,
+			&m.UpdatedAt,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning updated account mfa: %w", err)
+		}
+		out = append(out, m)
+	}
+
+	return out, nil
+}
+
+func (q AccountMFAQ) UpdateEnabled(enabled bool) AccountMFAQ {
+	q.updater = q.updater.Set("enabled", enabled)
+	return q
+}
+
+func (q AccountMFAQ) UpdateTOTPLastStep(step int64) AccountMFAQ {
+	q.updater = q.updater.Set("totp_last_step", step)
+	return q
+}
+
+func (q AccountMFAQ) Get(ctx context.Context) (AccountMFA, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return AccountMFA{}, fmt.Errorf("building get query for %s: %w", accountMFATable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var m AccountMFA
+	err = row.Scan(
+		&m.AccountID,
+		&m.TOTPSecret,
+		&m.Enabled,
+		&m.TOTPLastStep,
+		&m.UpdatedAt,
+		&m.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AccountMFA{}, nil
+		}
+		return AccountMFA{}, err
+	}
+
+	return m, nil
+}
+
+func (q AccountMFAQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", accountMFATable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q AccountMFAQ) FilterAccountID(accountID uuid.UUID) AccountMFAQ {
+	q.selector = q.selector.Where(sq.Eq{"account_id": accountID})
+	q.counter = q.counter.Where(sq.Eq{"account_id": accountID})
+	q.deleter = q.deleter.Where(sq.Eq{"account_id": accountID})
+	q.updater = q.updater.Where(sq.Eq{"account_id": accountID})
+	return q
+}
+
+func (q AccountMFAQ) FilterEnabled(enabled bool) AccountMFAQ {
+	q.selector = q.selector.Where(sq.Eq{"enabled": enabled})
+	q.counter = q.counter.Where(sq.Eq{"enabled": enabled})
+	q.deleter = q.deleter.Where(sq.Eq{"enabled": enabled})
+	q.updater = q.updater.Where(sq.Eq{"enabled": enabled})
+	return q
+}
+
+// FilterTOTPStepBefore keeps rows whose last accepted TOTP step is older than step,
+// so a code can be consumed only once.
+func (q AccountMFAQ) FilterTOTPStepBefore(step int64) AccountMFAQ {
+	q.selector = q.selector.Where(sq.Lt{"totp_last_step": step})
+	q.counter = q.counter.Where(sq.Lt{"totp_last_step": step})
+	q.deleter = q.deleter.Where(sq.Lt{"totp_last_step": step})
+	q.updater = q.updater.Where(sq.Lt{"totp_last_step": step})
+	return q
+}
+
+func (q AccountMFAQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", accountMFATable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q AccountMFAQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,278 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const accountMFARecoveryCodesTable = "account_mfa_recovery_codes"
+
+type AccountMFARecoveryCode struct {
+	ID        uuid.UUID  `db:"id"`
+	AccountID uuid.UUID  `db:"account_id"`
+	CodeHash  string     `db:"code_hash"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type AccountMFARecoveryCodesQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewAccountMFARecoveryCodes(db *sql.DB) AccountMFARecoveryCodesQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return AccountMFARecoveryCodesQ{
+		db:       db,
+		selector: builder.Select("account_mfa_recovery_codes.*").From(accountMFARecoveryCodesTable),
+		inserter: builder.Insert(accountMFARecoveryCodesTable),
+		updater:  builder.Update(accountMFARecoveryCodesTable),
+		deleter:  builder.Delete(accountMFARecoveryCodesTable),
+		counter:  builder.Select("COUNT(*) AS count").From(accountMFARecoveryCodesTable),
+	}
+}
+
+func (q AccountMFARecoveryCodesQ) New() AccountMFARecoveryCodesQ {
+	return NewAccountMFARecoveryCodes(q.db)
+}
+
+func (q AccountMFARecoveryCodesQ) Insert(ctx context.Context, input AccountMFARecoveryCode) error {
+	values := map[string]interface{}{
+		"id":         input.ID,
+		"account_id": input.AccountID,
+		"code_hash":  input.CodeHash,
+		"used_at":    input.UsedAt,
+		"created_at": input.CreatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", accountMFARecoveryCodesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q AccountMFARecoveryCodesQ) Update(ctx context.Context) ([]AccountMFARecoveryCode, error) {
+	q.updater = q.updater.Suffix("RETURNING account_mfa_recovery_codes.*")
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update query for %s: %w", accountMFARecoveryCodesTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []AccountMFARecoveryCode
+	for rows.Next() {
+		var c AccountMFARecoveryCode
+		err = rows.Scan(
+			&c.ID,
+			&c.AccountID,
+			&c.CodeHash,
+			&c.UsedAt,
+			&c.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning updated account mfa recovery code: %w", err)
+		}
+		out = append(out, c)
+	}
+
+	return out, nil
+}
+
+func (q AccountMFARecoveryCodesQ) UpdateUsedAt(usedAt time.Time) AccountMFARecoveryCodesQ {
+	q.updater = q.updater.Set("used_at", usedAt)
+	return q
+}
+
+func (q AccountMFARecoveryCodesQ) Get(ctx context.Context) (AccountMFARecoveryCode, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return AccountMFARecoveryCode{}, fmt.Errorf("building get query for %s: %w", accountMFARecoveryCodesTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var c AccountMFARecoveryCode
+	err = row.Scan(
+		&c.ID,
+		&c.AccountID,
+		&c.CodeHash,
+		&c.UsedAt,
+		&c.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AccountMFARecoveryCode{}, nil
+		}
+		return AccountMFARecoveryCode{}, err
+	}
+
+	return c, nil
+}
+
+func (q AccountMFARecoveryCodesQ) Select(ctx context.Context) ([]AccountMFARecoveryCode, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", accountMFARecoveryCodesTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []AccountMFARecoveryCode
+	for rows.Next() {
+		var c AccountMFARecoveryCode
+		err = rows.Scan(
+			&c.ID,
+			&c.AccountID,
+			&c.CodeHash,
+			&c.UsedAt,
+			&c.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning account mfa recovery code: %w", err)
+		}
+		out = append(out, c)
+	}
+
+	return out, nil
+}
+
+func (q AccountMFARecoveryCodesQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", accountMFARecoveryCodesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q AccountMFARecoveryCodesQ) FilterID(id uuid.UUID) AccountMFARecoveryCodesQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q AccountMFARecoveryCodesQ) FilterAccountID(accountID uuid.UUID) AccountMFARecoveryCodesQ {
+	q.selector = q.selector.Where(sq.Eq{"account_id": accountID})
+	q.counter = q.counter.Where(sq.Eq{"account_id": accountID})
+	q.deleter = q.deleter.Where(sq.Eq{"account_id": accountID})
+	q.updater = q.updater.Where(sq.Eq{"account_id": accountID})
+	return q
+}
+
+func (q AccountMFARecoveryCodesQ) FilterCodeHash(codeHash string) AccountMFARecoveryCodesQ {
+	q.selector = q.selector.Where(sq.Eq{"code_hash": codeHash})
+	q.counter = q.counter.Where(sq.Eq{"code_hash": codeHash})
+	q.deleter = q.deleter.Where(sq.Eq{"code_hash": codeHash})
+	q.updater = q.updater.Where(sq.Eq{"code_hash": codeHash})
+	return q
+}
+
+func (q AccountMFARecoveryCodesQ) FilterUnused() AccountMFARecoveryCodesQ {
+	q.selector = q.selector.Where(sq.Eq{"used_at": nil})
+	q.counter = q.counter.Where(sq.Eq{"used_at": nil})
+	q.deleter = q.deleter.Where(sq.Eq{"used_at": nil})
+	q.updater = q.updater.Where(sq.Eq{"used_at": nil})
+	return q
+}
+
+func (q AccountMFARecoveryCodesQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", accountMFARecoveryCodesTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q AccountMFARecoveryCodesQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
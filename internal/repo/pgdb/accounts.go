@@ -269,6 +269,45 @@ func (q AccountsQ) FilterEmail(email string) AccountsQ {
 	return q
 }
 
+// FilterUsernamePrefix matches usernames starting with the prefix, ignoring the case.
+func (q AccountsQ) FilterUsernamePrefix(prefix string) AccountsQ {
+	cond := sq.ILike{"accounts.username": escapeLike(prefix) + "%"}
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	return q
+}
+
+// FilterEmailPrefix matches emails starting with the prefix, ignoring the case.
+func (q AccountsQ) FilterEmailPrefix(prefix string) AccountsQ {
+	cond := sq.Expr(
+		"accounts.id IN (?)",
+		sq.Select("account_id").From("account_emails").Where(sq.ILike{"email": escapeLike(prefix) + "%"}),
+	)
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	return q
+}
+
+func (q AccountsQ) FilterCreatedAfter(t time.Time) AccountsQ {
+	q.selector = q.selector.Where(sq.GtOrEq{"accounts.created_at": t})
+	q.counter = q.counter.Where(sq.GtOrEq{"accounts.created_at": t})
+	return q
+}
+
+func (q AccountsQ) FilterCreatedBefore(t time.Time) AccountsQ {
+	q.selector = q.selector.Where(sq.Lt{"accounts.created_at": t})
+	q.counter = q.counter.Where(sq.Lt{"accounts.created_at": t})
+	return q
+}
+
+// ForUpdate locks the selected rows until the end of the transaction.
+func (q AccountsQ) ForUpdate() AccountsQ {
+	q.selector = q.selector.Suffix("FOR UPDATE")
+	return q
+}
+
 func (q AccountsQ) Count(ctx context.Context) (uint64, error) {
 	query, args, err := q.counter.ToSql()
 	if err != nil {
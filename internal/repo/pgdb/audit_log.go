@@ -0,0 +1,272 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const auditLogTable = "audit_log"
+
+type AuditLogEntry struct {
+	ID        uuid.UUID     `db:"id"`
+	ActorID   uuid.NullUUID `db:"actor_id"`
+	TargetID  uuid.NullUUID `db:"target_id"`
+	Action    string        `db:"action"`
+	IP        string        `db:"ip"`
+	UserAgent string        `db:"user_agent"`
+	Metadata  []byte        `db:"metadata"`
+	CreatedAt time.Time     `db:"created_at"`
+}
+
+type AuditLogQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewAuditLog(db *sql.DB) AuditLogQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return AuditLogQ{
+		db:       db,
+		selector: builder.Select("audit_log.*").From(auditLogTable),
+		inserter: builder.Insert(auditLogTable),
+		updater:  builder.Update(auditLogTable),
+		deleter:  builder.Delete(auditLogTable),
+		counter:  builder.Select("COUNT(*) AS count").From(auditLogTable),
+	}
+}
+
+func (q AuditLogQ) New() AuditLogQ {
+	return NewAuditLog(q.db)
+}
+
+func (q AuditLogQ) Insert(ctx context.Context, input AuditLogEntry) error {
+	values := map[string]interface{}{
+		"id":         input.ID,
+		"actor_id":   input.ActorID,
+		"target_id":  input.TargetID,
+		"action":     input.Action,
+		"ip":         input.IP,
+		"user_agent": input.UserAgent,
+		"metadata":   string(input.Metadata),
+		"created_at": input.CreatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", auditLogTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q AuditLogQ) Get(ctx context.Context) (AuditLogEntry, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return AuditLogEntry{}, fmt.Errorf("building get query for %s: %w", auditLogTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var e AuditLogEntry
+	err = row.Scan(
+		&e.ID,
+		&e.ActorID,
+		&e.TargetID,
+		&e.Action,
+		&e.IP,
+		&e.UserAgent,
+		&e.Metadata,
+		&e.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AuditLogEntry{}, nil
+		}
+		return AuditLogEntry{}, err
+	}
+
+	return e, nil
+}
+
+func (q AuditLogQ) Select(ctx context.Context) ([]AuditLogEntry, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", auditLogTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []AuditLogEntry
+	for rows.Next() {
+		var e AuditLogEntry
+		err = rows.Scan(
+			&e.ID,
+			&e.ActorID,
+			&e.TargetID,
+			&e.Action,
+			&e.IP,
+			&e.UserAgent,
+			&e.Metadata,
+			&e.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning audit log entry: %w", err)
+		}
+		out = append(out, e)
+	}
+
+	return out, nil
+}
+
+func (q AuditLogQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", auditLogTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q AuditLogQ) FilterID(id uuid.UUID) AuditLogQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q AuditLogQ) FilterActorID(actorID uuid.UUID) AuditLogQ {
+	q.selector = q.selector.Where(sq.Eq{"actor_id": actorID})
+	q.counter = q.counter.Where(sq.Eq{"actor_id": actorID})
+	q.deleter = q.deleter.Where(sq.Eq{"actor_id": actorID})
+	q.updater = q.updater.Where(sq.Eq{"actor_id": actorID})
+	return q
+}
+
+func (q AuditLogQ) FilterTargetID(targetID uuid.UUID) AuditLogQ {
+	q.selector = q.selector.Where(sq.Eq{"target_id": targetID})
+	q.counter = q.counter.Where(sq.Eq{"target_id": targetID})
+	q.deleter = q.deleter.Where(sq.Eq{"target_id": targetID})
+	q.updater = q.updater.Where(sq.Eq{"target_id": targetID})
+	return q
+}
+
+func (q AuditLogQ) FilterAction(action string) AuditLogQ {
+	q.selector = q.selector.Where(sq.Eq{"action": action})
+	q.counter = q.counter.Where(sq.Eq{"action": action})
+	q.deleter = q.deleter.Where(sq.Eq{"action": action})
+	q.updater = q.updater.Where(sq.Eq{"action": action})
+	return q
+}
+
+func (q AuditLogQ) FilterCreatedAfter(t time.Time) AuditLogQ {
+	q.selector = q.selector.Where(sq.GtOrEq{"created_at": t})
+	q.counter = q.counter.Where(sq.GtOrEq{"created_at": t})
+	q.deleter = q.deleter.Where(sq.GtOrEq{"created_at": t})
+	q.updater = q.updater.Where(sq.GtOrEq{"created_at": t})
+	return q
+}
+
+func (q AuditLogQ) FilterCreatedBefore(t time.Time) AuditLogQ {
+	q.selector = q.selector.Where(sq.Lt{"created_at": t})
+	q.counter = q.counter.Where(sq.Lt{"created_at": t})
+	q.deleter = q.deleter.Where(sq.Lt{"created_at": t})
+	q.updater = q.updater.Where(sq.Lt{"created_at": t})
+	return q
+}
+
+// FilterActorOrTargetID selects the entries the account did or that were done to it.
+func (q AuditLogQ) FilterActorOrTargetID(accountID uuid.UUID) AuditLogQ {
+	cond := sq.Or{sq.Eq{"actor_id": accountID}, sq.Eq{"target_id": accountID}}
+
+	q.selector = q.selector.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	return q
+}
+
+// FilterAfterCursor selects the entries older than the entry with the cursor id, in the
+// order of OrderNewestFirst.
+func (q AuditLogQ) FilterAfterCursor(cursor uuid.UUID) AuditLogQ {
+	q.selector = q.selector.Where(sq.Expr(
+		"(created_at, id) < (SELECT created_at, id FROM audit_log WHERE id = ?)", cursor,
+	))
+	return q
+}
+
+func (q AuditLogQ) OrderNewestFirst() AuditLogQ {
+	q.selector = q.selector.OrderBy("created_at DESC", "id DESC")
+	return q
+}
+
+func (q AuditLogQ) Limit(limit uint64) AuditLogQ {
+	q.selector = q.selector.Limit(limit)
+	return q
+}
+
+func (q AuditLogQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,351 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const inboxEventsTable = "inbox_events"
+
+type InboxEvent struct {
+	ID          uuid.UUID  `db:"id"`
+	Topic       string     `db:"topic"`
+	Key         string     `db:"key"`
+	Type        string     `db:"type"`
+	Version     int32      `db:"version"`
+	Producer    string     `db:"producer"`
+	Payload     []byte     `db:"payload"`
+	Status      string     `db:"status"`
+	Attempts    int32      `db:"attempts"`
+	CreatedAt   time.Time  `db:"created_at"`
+	NextRetryAt *time.Time `db:"next_retry_at"`
+	ProcessedAt *time.Time `db:"processed_at"`
+}
+
+type InboxEventsQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewInboxEvents(db *sql.DB) InboxEventsQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return InboxEventsQ{
+		db:       db,
+		selector: builder.Select("inbox_events.*").From(inboxEventsTable),
+		inserter: builder.Insert(inboxEventsTable),
+		updater:  builder.Update(inboxEventsTable),
+		deleter:  builder.Delete(inboxEventsTable),
+		counter:  builder.Select("COUNT(*) AS count").From(inboxEventsTable),
+	}
+}
+
+func (q InboxEventsQ) New() InboxEventsQ {
+	return NewInboxEvents(q.db)
+}
+
+func (q InboxEventsQ) Insert(ctx context.Context, input InboxEvent) error {
+	values := map[string]interface{}{
+		"id":            input.ID,
+		"topic":         input.Topic,
+		"key":           input.Key,
+		"type":          input.Type,
+		"version":       input.Version,
+		"producer":      input.Producer,
+		"payload":       string(input.Payload),
+		"status":        input.Status,
+		"attempts":      input.Attempts,
+		"created_at":    input.CreatedAt,
+		"next_retry_at": input.NextRetryAt,
+		"processed_at":  input.ProcessedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", inboxEventsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q InboxEventsQ) Update(ctx context.Context) ([]InboxEvent, error) {
+	q.updater = q.updater.Suffix("RETURNING inbox_events.*")
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update query for %s: %w", inboxEventsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []InboxEvent
+	for rows.Next() {
+		var e InboxEvent
+		err = rows.Scan(
+			&e.ID,
+			&e.Topic,
+			&e.Key,
+			&e.Type,
+			&e.Version,
+			&e.Producer,
+			&e.Payload,
+			&e.Status,
+			&e.Attempts,
+			&e.CreatedAt,
+			&e.NextRetryAt,
+			&e.ProcessedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning updated inbox event: %w", err)
+		}
+		out = append(out, e)
+	}
+
+	return out, nil
+}
+
+func (q InboxEventsQ) UpdateStatus(status string) InboxEventsQ {
+	q.updater = q.updater.Set("status", status)
+	return q
+}
+
+func (q InboxEventsQ) UpdateNextRetryAt(nextRetryAt *time.Time) InboxEventsQ {
+	q.updater = q.updater.Set("next_retry_at", nextRetryAt)
+	return q
+}
+
+func (q InboxEventsQ) UpdateProcessedAt(processedAt *time.Time) InboxEventsQ {
+	q.updater = q.updater.Set("processed_at", processedAt)
+	return q
+}
+
+func (q InboxEventsQ) Get(ctx context.Context) (InboxEvent, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return InboxEvent{}, fmt.Errorf("building get query for %s: %w", inboxEventsTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var e InboxEvent
+	err = row.Scan(
+		&e.ID,
+		&e.Topic,
+		&e.Key,
+		&e.Type,
+		&e.Version,
+		&e.Producer,
+		&e.Payload,
+		&e.Status,
+		&e.Attempts,
+		&e.CreatedAt,
+		&e.NextRetryAt,
+		&e.ProcessedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return InboxEvent{}, nil
+		}
+		return InboxEvent{}, err
+	}
+
+	return e, nil
+}
+
+func (q InboxEventsQ) Select(ctx context.Context) ([]InboxEvent, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", inboxEventsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []InboxEvent
+	for rows.Next() {
+		var e InboxEvent
+		err = rows.Scan(
+			&e.ID,
+			&e.Topic,
+			&e.Key,
+			&e.Type,
+			&e.Version,
+			&e.Producer,
+			&e.Payload,
+			&e.Status,
+			&e.Attempts,
+			&e.CreatedAt,
+			&e.NextRetryAt,
+			&e.ProcessedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning inbox event: %w", err)
+		}
+		out = append(out, e)
+	}
+
+	return out, nil
+}
+
+func (q InboxEventsQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", inboxEventsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q InboxEventsQ) FilterID(id uuid.UUID) InboxEventsQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q InboxEventsQ) FilterStatus(status string) InboxEventsQ {
+	q.selector = q.selector.Where(sq.Eq{"status": status})
+	q.counter = q.counter.Where(sq.Eq{"status": status})
+	q.deleter = q.deleter.Where(sq.Eq{"status": status})
+	q.updater = q.updater.Where(sq.Eq{"status": status})
+	return q
+}
+
+// FilterReady selects the events with no retry delay or whose delay has passed by t.
+func (q InboxEventsQ) FilterReady(t time.Time) InboxEventsQ {
+	cond := sq.Or{sq.Eq{"next_retry_at": nil}, sq.LtOrEq{"next_retry_at": t}}
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	return q
+}
+
+func (q InboxEventsQ) IncrementAttempts() InboxEventsQ {
+	q.updater = q.updater.Set("attempts", sq.Expr("attempts + 1"))
+	return q
+}
+
+// OnConflictDoNothing makes Insert skip events whose id is already stored, so a message
+// delivered twice is kept once.
+func (q InboxEventsQ) OnConflictDoNothing() InboxEventsQ {
+	q.inserter = q.inserter.Suffix("ON CONFLICT (id) DO NOTHING")
+	return q
+}
+
+// ForUpdateSkipLocked locks the selected rows until the end of the transaction and skips
+// the rows another transaction has locked.
+func (q InboxEventsQ) ForUpdateSkipLocked() InboxEventsQ {
+	q.selector = q.selector.Suffix("FOR UPDATE SKIP LOCKED")
+	return q
+}
+
+func (q InboxEventsQ) OrderCreatedAt(ascending bool) InboxEventsQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("created_at ASC")
+	} else {
+		q.selector = q.selector.OrderBy("created_at DESC")
+	}
+	return q
+}
+
+func (q InboxEventsQ) Limit(limit uint64) InboxEventsQ {
+	q.selector = q.selector.Limit(limit)
+	return q
+}
+
+func (q InboxEventsQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", inboxEventsTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q InboxEventsQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+const loginThrottlesTable = "login_throttles"
+
+type LoginThrottle struct {
+	Kind           string     `db:"kind"`
+	Subject        string     `db:"subject"`
+	FailedAttempts int32      `db:"failed_attempts"`
+	Lockouts       int32      `db:"lockouts"`
+	LockedUntil    *time.Time `db:"locked_until"`
+	LastFailedAt   time.Time  `db:"last_failed_at"`
+}
+
+type LoginThrottlesQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewLoginThrottles(db *sql.DB) LoginThrottlesQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return LoginThrottlesQ{
+		db:       db,
+		selector: builder.Select("login_throttles.*").From(loginThrottlesTable),
+		inserter: builder.Insert(loginThrottlesTable),
+		updater:  builder.Update(loginThrottlesTable),
+		deleter:  builder.Delete(loginThrottlesTable),
+		counter:  builder.Select("COUNT(*) AS count").From(loginThrottlesTable),
+	}
+}
+
+func (q LoginThrottlesQ) New() LoginThrottlesQ {
+	return NewLoginThrottles(q.db)
+}
+
+func (q LoginThrottlesQ) Insert(ctx context.Context, input LoginThrottle) error {
+	values := map[string]interface{}{
+		"kind":            input.Kind,
+		"subject":         input.Subject,
+		"failed_attempts": input.FailedAttempts,
+		"lockouts":        input.Lockouts,
+		"locked_until":    input.LockedUntil,
+		"last_failed_at":  input.LastFailedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", loginThrottlesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q LoginThrottlesQ) Update(ctx context.Context) ([]LoginThrottle, error) {
+	q.updater = q.updater.Suffix("RETURNING login_throttles.*")
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update query for %s: %w", loginThrottlesTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []LoginThrottle
+	for rows.Next() {
+		var t LoginThrottle
+		err = rows.Scan(
+			&t.Kind,
+			&t.Subject,
+			&t.FailedAttempts,
+			&t.Lockouts,
+			&t.LockedUntil,
+			&t.LastFailedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning updated login throttle: %w", err)
+		}
+		out = append(out, t)
+	}
+
+	return out, nil
+}
+
+func (q LoginThrottlesQ) UpdateFailedAttempts(failedAttempts int32) LoginThrottlesQ {
+	q.updater = q.updater.Set("failed_attempts", failedAttempts)
+	return q
+}
+
+func (q LoginThrottlesQ) UpdateLockouts(lockouts int32) LoginThrottlesQ {
+	q.updater = q.updater.Set("lockouts", lockouts)
+	return q
+}
+
+func (q LoginThrottlesQ) UpdateLockedUntil(lockedUntil *time.Time) LoginThrottlesQ {
+	q.updater = q.updater.Set("locked_until", lockedUntil)
+	return q
+}
+
+func (q LoginThrottlesQ) UpdateLastFailedAt(lastFailedAt time.Time) LoginThrottlesQ {
+	q.updater = q.updater.Set("last_failed_at", lastFailedAt)
+	return q
+}
+
+func (q LoginThrottlesQ) Get(ctx context.Context) (LoginThrottle, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return LoginThrottle{}, fmt.Errorf("building get query for %s: %w", loginThrottlesTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var t LoginThrottle
+	err = row.Scan(
+		&t.Kind,
+		&t.Subject,
+		&t.FailedAttempts,
+		&t.Lockouts,
+		&t.LockedUntil,
+		&t.LastFailedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return LoginThrottle{}, nil
+		}
+		return LoginThrottle{}, err
+	}
+
+	return t, nil
+}
+
+func (q LoginThrottlesQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", loginThrottlesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q LoginThrottlesQ) FilterKind(kind string) LoginThrottlesQ {
+	q.selector = q.selector.Where(sq.Eq{"kind": kind})
+	q.counter = q.counter.Where(sq.Eq{"kind": kind})
+	q.deleter = q.deleter.Where(sq.Eq{"kind": kind})
+	q.updater = q.updater.Where(sq.Eq{"kind": kind})
+	return q
+}
+
+func (q LoginThrottlesQ) FilterSubject(subject string) LoginThrottlesQ {
+	q.selector = q.selector.Where(sq.Eq{"subject": subject})
+	q.counter = q.counter.Where(sq.Eq{"subject": subject})
+	q.deleter = q.deleter.Where(sq.Eq{"subject": subject})
+	q.updater = q.updater.Where(sq.Eq{"subject": subject})
+	return q
+}
+
+// ForUpdate locks the selected rows until the end of the transaction.
+func (q LoginThrottlesQ) ForUpdate() LoginThrottlesQ {
+	q.selector = q.selector.Suffix("FOR UPDATE")
+	return q
+}
+
+// OnConflictDoNothing makes Insert skip rows whose key already exists.
+func (q LoginThrottlesQ) OnConflictDoNothing() LoginThrottlesQ {
+	q.inserter = q.inserter.Suffix("ON CONFLICT DO NOTHING")
+	return q
+}
+
+func (q LoginThrottlesQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", loginThrottlesTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q LoginThrottlesQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const mfaChallengesTable = "mfa_challenges"
+
+type MFAChallenge struct {
+	ID        uuid.UUID `db:"id"`
+	AccountID uuid.UUID `db:"account_id"`
+	Attempts  int32     `db:"attempts"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type MFAChallengesQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewMFAChallenges(db *sql.DB) MFAChallengesQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return MFAChallengesQ{
+		db:       db,
+		selector: builder.Select("mfa_challenges.*").From(mfaChallengesTable),
+		inserter: builder.Insert(mfaChallengesTable),
+		updater:  builder.Update(mfaChallengesTable),
+		deleter:  builder.Delete(mfaChallengesTable),
+		counter:  builder.Select("COUNT(*) AS count").From(mfaChallengesTable),
+	}
+}
+
+func (q MFAChallengesQ) New() MFAChallengesQ {
+	return NewMFAChallenges(q.db)
+}
+
+func (q MFAChallengesQ) Insert(ctx context.Context, input MFAChallenge) error {
+	values := map[string]interface{}{
+		"id":         input.ID,
+		"account_id": input.AccountID,
+		"attempts":   input.Attempts,
+		"expires_at": input.ExpiresAt,
+		"created_at": input.CreatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", mfaChallengesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q MFAChallengesQ) Update(ctx context.Context) ([]MFAChallenge, error) {
+	q.updater = q.updater.Suffix("RETURNING mfa_challenges.*")
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update query for %s: %w", mfaChallengesTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []MFAChallenge
+	for rows.Next() {
+		var c MFAChallenge
+		err = rows.Scan(
+			&c.ID,
+			&c.AccountID,
+			&c.Attempts,
+			&c.ExpiresAt,
+			&c.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning updated mfa challenge: %w", err)
+		}
+		out = append(out, c)
+	}
+
+	return out, nil
+}
+
+// IncrementAttempts counts one more code checked against the challenge.
+func (q MFAChallengesQ) IncrementAttempts() MFAChallengesQ {
+	q.updater = q.updater.Set("attempts", sq.Expr("attempts + 1"))
+	return q
+}
+
+func (q MFAChallengesQ) Get(ctx context.Context) (MFAChallenge, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return MFAChallenge{}, fmt.Errorf("building get query for %s: %w", mfaChallengesTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var c MFAChallenge
+	err = row.Scan(
+		&c.ID,
+		&c.AccountID,
+		&c.Attempts,
+		&c.ExpiresAt,
+		&c.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return MFAChallenge{}, nil
+		}
+		return MFAChallenge{}, err
+	}
+
+	return c, nil
+}
+
+func (q MFAChallengesQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", mfaChallengesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q MFAChallengesQ) FilterID(id uuid.UUID) MFAChallengesQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	return q
+}
+
+// FilterAttemptsBelow selects the challenges checked fewer than n times.
+func (q MFAChallengesQ) FilterAttemptsBelow(n int32) MFAChallengesQ {
+	q.selector = q.selector.Where(sq.Lt{"attempts": n})
+	q.counter = q.counter.Where(sq.Lt{"attempts": n})
+	q.deleter = q.deleter.Where(sq.Lt{"attempts": n})
+	q.updater = q.updater.Where(sq.Lt{"attempts": n})
+	return q
+}
+
+func (q MFAChallengesQ) FilterExpiredBefore(t time.Time) MFAChallengesQ {
+	q.selector = q.selector.Where(sq.Lt{"expires_at": t})
+	q.counter = q.counter.Where(sq.Lt{"expires_at": t})
+	q.deleter = q.deleter.Where(sq.Lt{"expires_at": t})
+	q.updater = q.updater.Where(sq.Lt{"expires_at": t})
+	return q
+}
+
+// ForUpdate locks the selected rows until the end of the transaction.
+func (q MFAChallengesQ) ForUpdate() MFAChallengesQ {
+	q.selector = q.selector.Suffix("FOR UPDATE")
+	return q
+}
+
+func (q MFAChallengesQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", mfaChallengesTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q MFAChallengesQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
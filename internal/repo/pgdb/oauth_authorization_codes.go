@@ -0,0 +1,208 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const oauthAuthorizationCodesTable = "oauth_authorization_codes"
+
+type OAuthAuthorizationCode struct {
+	CodeHash            string    `db:"code_hash"`
+	ClientID            string    `db:"client_id"`
+	AccountID           uuid.UUID `db:"account_id"`
+	RedirectURI         string    `db:"redirect_uri"`
+	Scopes              []string  `db:"scopes"`
+	Nonce               string    `db:"nonce"`
+	CodeChallenge       string    `db:"code_challenge"`
+	CodeChallengeMethod string    `db:"code_challenge_method"`
+	AuthTime            time.Time `db:"auth_time"`
+	ExpiresAt           time.Time `db:"expires_at"`
+	CreatedAt           time.Time `db:"created_at"`
+}
+
+type OAuthAuthorizationCodesQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewOAuthAuthorizationCodes(db *sql.DB) OAuthAuthorizationCodesQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return OAuthAuthorizationCodesQ{
+		db:       db,
+		selector: builder.Select("oauth_authorization_codes.*").From(oauthAuthorizationCodesTable),
+		inserter: builder.Insert(oauthAuthorizationCodesTable),
+		updater:  builder.Update(oauthAuthorizationCodesTable),
+		deleter:  builder.Delete(oauthAuthorizationCodesTable),
+		counter:  builder.Select("COUNT(*) AS count").From(oauthAuthorizationCodesTable),
+	}
+}
+
+func (q OAuthAuthorizationCodesQ) New() OAuthAuthorizationCodesQ {
+	return NewOAuthAuthorizationCodes(q.db)
+}
+
+func (q OAuthAuthorizationCodesQ) Insert(ctx context.Context, input OAuthAuthorizationCode) error {
+	values := map[string]interface{}{
+		"code_hash":             input.CodeHash,
+		"client_id":             input.ClientID,
+		"account_id":            input.AccountID,
+		"redirect_uri":          input.RedirectURI,
+		"scopes":                pq.Array(input.Scopes),
+		"nonce":                 input.Nonce,
+		"code_challenge":        input.CodeChallenge,
+		"code_challenge_method": input.CodeChallengeMethod,
+		"auth_time":             input.AuthTime,
+		"expires_at":            input.ExpiresAt,
+		"created_at":            input.CreatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", oauthAuthorizationCodesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q OAuthAuthorizationCodesQ) Get(ctx context.Context) (OAuthAuthorizationCode, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return OAuthAuthorizationCode{}, fmt.Errorf("building get query for %s: %w", oauthAuthorizationCodesTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var c OAuthAuthorizationCode
+	err = row.Scan(
+		&c.CodeHash,
+		&c.ClientID,
+		&c.AccountID,
+		&c.RedirectURI,
+		pq.Array(&c.Scopes),
+		&c.Nonce,
+		&c.CodeChallenge,
+		&c.CodeChallengeMethod,
+		&c.AuthTime,
+		&c.ExpiresAt,
+		&c.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OAuthAuthorizationCode{}, nil
+		}
+		return OAuthAuthorizationCode{}, err
+	}
+
+	return c, nil
+}
+
+func (q OAuthAuthorizationCodesQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", oauthAuthorizationCodesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q OAuthAuthorizationCodesQ) FilterCodeHash(codeHash string) OAuthAuthorizationCodesQ {
+	q.selector = q.selector.Where(sq.Eq{"code_hash": codeHash})
+	q.counter = q.counter.Where(sq.Eq{"code_hash": codeHash})
+	q.deleter = q.deleter.Where(sq.Eq{"code_hash": codeHash})
+	q.updater = q.updater.Where(sq.Eq{"code_hash": codeHash})
+	return q
+}
+
+func (q OAuthAuthorizationCodesQ) FilterExpiredBefore(t time.Time) OAuthAuthorizationCodesQ {
+	q.selector = q.selector.Where(sq.Lt{"expires_at": t})
+	q.counter = q.counter.Where(sq.Lt{"expires_at": t})
+	q.deleter = q.deleter.Where(sq.Lt{"expires_at": t})
+	q.updater = q.updater.Where(sq.Lt{"expires_at": t})
+	return q
+}
+
+// ForUpdate locks the selected rows until the end of the transaction.
+func (q OAuthAuthorizationCodesQ) ForUpdate() OAuthAuthorizationCodesQ {
+	q.selector = q.selector.Suffix("FOR UPDATE")
+	return q
+}
+
+func (q OAuthAuthorizationCodesQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", oauthAuthorizationCodesTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q OAuthAuthorizationCodesQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
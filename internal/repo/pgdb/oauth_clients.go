@@ -0,0 +1,223 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+const oauthClientsTable = "oauth_clients"
+
+type OAuthClient struct {
+	ID           string    `db:"id"`
+	SecretHash   *string   `db:"secret_hash"`
+	Name         string    `db:"name"`
+	RedirectURIs []string  `db:"redirect_uris"`
+	Scopes       []string  `db:"scopes"`
+	SkipConsent  bool      `db:"skip_consent"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+type OAuthClientsQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewOAuthClients(db *sql.DB) OAuthClientsQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return OAuthClientsQ{
+		db:       db,
+		selector: builder.Select("oauth_clients.*").From(oauthClientsTable),
+		inserter: builder.Insert(oauthClientsTable),
+		updater:  builder.Update(oauthClientsTable),
+		deleter:  builder.Delete(oauthClientsTable),
+		counter:  builder.Select("COUNT(*) AS count").From(oauthClientsTable),
+	}
+}
+
+func (q OAuthClientsQ) New() OAuthClientsQ {
+	return NewOAuthClients(q.db)
+}
+
+func (q OAuthClientsQ) Insert(ctx context.Context, input OAuthClient) error {
+	values := map[string]interface{}{
+		"id":            input.ID,
+		"secret_hash":   input.SecretHash,
+		"name":          input.Name,
+		"redirect_uris": pq.Array(input.RedirectURIs),
+		"scopes":        pq.Array(input.Scopes),
+		"skip_consent":  input.SkipConsent,
+		"created_at":    input.CreatedAt,
+		"updated_at":    input.UpdatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", oauthClientsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q OAuthClientsQ) Get(ctx context.Context) (OAuthClient, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return OAuthClient{}, fmt.Errorf("building get query for %s: %w", oauthClientsTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var c OAuthClient
+	err = row.Scan(
+		&c.ID,
+		&c.SecretHash,
+		&c.Name,
+		pq.Array(&c.RedirectURIs),
+		pq.Array(&c.Scopes),
+		&c.SkipConsent,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OAuthClient{}, nil
+		}
+		return OAuthClient{}, err
+	}
+
+	return c, nil
+}
+
+func (q OAuthClientsQ) Select(ctx context.Context) ([]OAuthClient, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", oauthClientsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []OAuthClient
+	for rows.Next() {
+		var c OAuthClient
+		err = rows.Scan(
+			&c.ID,
+			&c.SecretHash,
+			&c.Name,
+			pq.Array(&c.RedirectURIs),
+			pq.Array(&c.Scopes),
+			&c.SkipConsent,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning oauth client: %w", err)
+		}
+		out = append(out, c)
+	}
+
+	return out, nil
+}
+
+func (q OAuthClientsQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", oauthClientsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q OAuthClientsQ) FilterID(id string) OAuthClientsQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q OAuthClientsQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", oauthClientsTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q OAuthClientsQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
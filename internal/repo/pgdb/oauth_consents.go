@@ -0,0 +1,190 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const oauthConsentsTable = "oauth_consents"
+
+type OAuthConsent struct {
+	AccountID uuid.UUID `db:"account_id"`
+	ClientID  string    `db:"client_id"`
+	Scopes    []string  `db:"scopes"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type OAuthConsentsQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewOAuthConsents(db *sql.DB) OAuthConsentsQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return OAuthConsentsQ{
+		db:       db,
+		selector: builder.Select("oauth_consents.*").From(oauthConsentsTable),
+		inserter: builder.Insert(oauthConsentsTable),
+		updater:  builder.Update(oauthConsentsTable),
+		deleter:  builder.Delete(oauthConsentsTable),
+		counter:  builder.Select("COUNT(*) AS count").From(oauthConsentsTable),
+	}
+}
+
+func (q OAuthConsentsQ) New() OAuthConsentsQ {
+	return NewOAuthConsents(q.db)
+}
+
+func (q OAuthConsentsQ) Insert(ctx context.Context, input OAuthConsent) error {
+	values := map[string]interface{}{
+		"account_id": input.AccountID,
+		"client_id":  input.ClientID,
+		"scopes":     pq.Array(input.Scopes),
+		"created_at": input.CreatedAt,
+		"updated_at": input.UpdatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", oauthConsentsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q OAuthConsentsQ) Get(ctx context.Context) (OAuthConsent, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return OAuthConsent{}, fmt.Errorf("building get query for %s: %w", oauthConsentsTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var c OAuthConsent
+	err = row.Scan(
+		&c.AccountID,
+		&c.ClientID,
+		pq.Array(&c.Scopes),
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OAuthConsent{}, nil
+		}
+		return OAuthConsent{}, err
+	}
+
+	return c, nil
+}
+
+func (q OAuthConsentsQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", oauthConsentsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q OAuthConsentsQ) FilterAccountID(accountID uuid.UUID) OAuthConsentsQ {
+	q.selector = q.selector.Where(sq.Eq{"account_id": accountID})
+	q.counter = q.counter.Where(sq.Eq{"account_id": accountID})
+	q.deleter = q.deleter.Where(sq.Eq{"account_id": accountID})
+	q.updater = q.updater.Where(sq.Eq{"account_id": accountID})
+	return q
+}
+
+func (q OAuthConsentsQ) FilterClientID(clientID string) OAuthConsentsQ {
+	q.selector = q.selector.Where(sq.Eq{"client_id": clientID})
+	q.counter = q.counter.Where(sq.Eq{"client_id": clientID})
+	q.deleter = q.deleter.Where(sq.Eq{"client_id": clientID})
+	q.updater = q.updater.Where(sq.Eq{"client_id": clientID})
+	return q
+}
+
+// OnConflictUpdateScopes makes Insert replace the scopes of an existing consent.
+func (q OAuthConsentsQ) OnConflictUpdateScopes() OAuthConsentsQ {
+	q.inserter = q.inserter.Suffix("ON CONFLICT (account_id, client_id) DO UPDATE SET scopes = EXCLUDED.scopes, updated_at = EXCLUDED.updated_at")
+	return q
+}
+
+func (q OAuthConsentsQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", oauthConsentsTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q OAuthConsentsQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
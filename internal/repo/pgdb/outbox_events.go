@@ -0,0 +1,413 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const outboxEventsTable = "outbox_events"
+
+type OutboxEvent struct {
+	ID          uuid.UUID  `db:"id"`
+	Topic       string     `db:"topic"`
+	Key         string     `db:"key"`
+	Type        string     `db:"type"`
+	Version     int32      `db:"version"`
+	Producer    string     `db:"producer"`
+	Payload     []byte     `db:"payload"`
+	Status      string     `db:"status"`
+	Attempts    int32      `db:"attempts"`
+	CreatedAt   time.Time  `db:"created_at"`
+	NextRetryAt *time.Time `db:"next_retry_at"`
+	SentAt      *time.Time `db:"sent_at"`
+	LockedUntil *time.Time `db:"locked_until"`
+	// ClaimID identifies the claim holding a processing event, only its holder may mark it.
+	ClaimID uuid.NullUUID `db:"claim_id"`
+}
+
+type OutboxEventsQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewOutboxEvents(db *sql.DB) OutboxEventsQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return OutboxEventsQ{
+		db:       db,
+		selector: builder.Select("outbox_events.*").From(outboxEventsTable),
+		inserter: builder.Insert(outboxEventsTable),
+		updater:  builder.Update(outboxEventsTable),
+		deleter:  builder.Delete(outboxEventsTable),
+		counter:  builder.Select("COUNT(*) AS count").From(outboxEventsTable),
+	}
+}
+
+func (q OutboxEventsQ) New() OutboxEventsQ {
+	return NewOutboxEvents(q.db)
+}
+
+func (q OutboxEventsQ) Insert(ctx context.Context, input OutboxEvent) error {
+	values := map[string]interface{}{
+		"id":            input.ID,
+		"topic":         input.Topic,
+		"key":           input.Key,
+		"type":          input.Type,
+		"version":       input.Version,
+		"producer":      input.Producer,
+		"payload":       string(input.Payload),
+		"status":        input.Status,
+		"attempts":      input.Attempts,
+		"created_at":    input.CreatedAt,
+		"next_retry_at": input.NextRetryAt,
+		"sent_at":       input.SentAt,
+		"locked_until":  input.LockedUntil,
+		"claim_id":      input.ClaimID,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", outboxEventsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q OutboxEventsQ) Update(ctx context.Context) ([]OutboxEvent, error) {
+	q.updater = q.updater.Suffix("RETURNING outbox_events.*")
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update query for %s: %w", outboxEventsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []OutboxEvent
+	for rows.Next() {
+		var e OutboxEvent
+		err = rows.Scan(
+			&e.ID,
+			&e.Topic,
+			&e.Key,
+			&e.Type,
+			&e.Version,
+			&e.Producer,
+			&e.Payload,
+			&e.Status,
+			&e.Attempts,
+			&e.CreatedAt,
+			&e.NextRetryAt,
+			&e.SentAt,
+			&e.LockedUntil,
+			&e.ClaimID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning updated outbox event: %w", err)
+		}
+		out = append(out, e)
+	}
+
+	return out, nil
+}
+
+func (q OutboxEventsQ) UpdateStatus(status string) OutboxEventsQ {
+	q.updater = q.updater.Set("status", status)
+	return q
+}
+
+func (q OutboxEventsQ) UpdateNextRetryAt(nextRetryAt *time.Time) OutboxEventsQ {
+	q.updater = q.updater.Set("next_retry_at", nextRetryAt)
+	return q
+}
+
+func (q OutboxEventsQ) UpdateSentAt(sentAt *time.Time) OutboxEventsQ {
+	q.updater = q.updater.Set("sent_at", sentAt)
+	return q
+}
+
+func (q OutboxEventsQ) UpdateAttempts(attempts int32) OutboxEventsQ {
+	q.updater = q.updater.Set("attempts", attempts)
+	return q
+}
+
+func (q OutboxEventsQ) UpdateLockedUntil(lockedUntil *time.Time) OutboxEventsQ {
+	q.updater = q.updater.Set("locked_until", lockedUntil)
+	return q
+}
+
+func (q OutboxEventsQ) UpdateClaimID(claimID uuid.NullUUID) OutboxEventsQ {
+	q.updater = q.updater.Set("claim_id", claimID)
+	return q
+}
+
+func (q OutboxEventsQ) Get(ctx context.Context) (OutboxEvent, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return OutboxEvent{}, fmt.Errorf("building get query for %s: %w", outboxEventsTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var e OutboxEvent
+	err = row.Scan(
+		&e.ID,
+		&e.Topic,
+		&e.Key,
+		&e.Type,
+		&e.Version,
+		&e.Producer,
+		&e.Payload,
+		&e.Status,
+		&e.Attempts,
+		&e.CreatedAt,
+		&e.NextRetryAt,
+		&e.SentAt,
+		&e.LockedUntil,
+		&e.ClaimID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OutboxEvent{}, nil
+		}
+		return OutboxEvent{}, err
+	}
+
+	return e, nil
+}
+
+func (q OutboxEventsQ) Select(ctx context.Context) ([]OutboxEvent, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", outboxEventsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []OutboxEvent
+	for rows.Next() {
+		var e OutboxEvent
+		err = rows.Scan(
+			&e.ID,
+			&e.Topic,
+			&e.Key,
+			&e.Type,
+			&e.Version,
+			&e.Producer,
+			&e.Payload,
+			&e.Status,
+			&e.Attempts,
+			&e.CreatedAt,
+			&e.NextRetryAt,
+			&e.SentAt,
+			&e.LockedUntil,
+			&e.ClaimID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning outbox event: %w", err)
+		}
+		out = append(out, e)
+	}
+
+	return out, nil
+}
+
+func (q OutboxEventsQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", outboxEventsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q OutboxEventsQ) FilterID(id uuid.UUID) OutboxEventsQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q OutboxEventsQ) FilterIDs(ids []uuid.UUID) OutboxEventsQ {
+	q.selector = q.selector.Where(sq.Eq{"id": ids})
+	q.counter = q.counter.Where(sq.Eq{"id": ids})
+	q.deleter = q.deleter.Where(sq.Eq{"id": ids})
+	q.updater = q.updater.Where(sq.Eq{"id": ids})
+	return q
+}
+
+func (q OutboxEventsQ) FilterStatus(status string) OutboxEventsQ {
+	q.selector = q.selector.Where(sq.Eq{"status": status})
+	q.counter = q.counter.Where(sq.Eq{"status": status})
+	q.deleter = q.deleter.Where(sq.Eq{"status": status})
+	q.updater = q.updater.Where(sq.Eq{"status": status})
+	return q
+}
+
+func (q OutboxEventsQ) FilterCreatedBefore(t time.Time) OutboxEventsQ {
+	q.selector = q.selector.Where(sq.Lt{"created_at": t})
+	q.counter = q.counter.Where(sq.Lt{"created_at": t})
+	q.deleter = q.deleter.Where(sq.Lt{"created_at": t})
+	q.updater = q.updater.Where(sq.Lt{"created_at": t})
+	return q
+}
+
+func (q OutboxEventsQ) FilterStatuses(statuses []string) OutboxEventsQ {
+	q.selector = q.selector.Where(sq.Eq{"status": statuses})
+	q.counter = q.counter.Where(sq.Eq{"status": statuses})
+	q.deleter = q.deleter.Where(sq.Eq{"status": statuses})
+	q.updater = q.updater.Where(sq.Eq{"status": statuses})
+	return q
+}
+
+func (q OutboxEventsQ) FilterClaimID(claimID uuid.UUID) OutboxEventsQ {
+	q.selector = q.selector.Where(sq.Eq{"claim_id": claimID})
+	q.counter = q.counter.Where(sq.Eq{"claim_id": claimID})
+	q.deleter = q.deleter.Where(sq.Eq{"claim_id": claimID})
+	q.updater = q.updater.Where(sq.Eq{"claim_id": claimID})
+	return q
+}
+
+// FilterReady selects the events with no retry delay or whose delay has passed by t.
+func (q OutboxEventsQ) FilterReady(t time.Time) OutboxEventsQ {
+	cond := sq.Or{sq.Eq{"next_retry_at": nil}, sq.LtOrEq{"next_retry_at": t}}
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	return q
+}
+
+// FilterLeaseExpired selects the events whose lease has run out by t.
+func (q OutboxEventsQ) FilterLeaseExpired(t time.Time) OutboxEventsQ {
+	cond := sq.Lt{"locked_until": t}
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	return q
+}
+
+func (q OutboxEventsQ) IncrementAttempts() OutboxEventsQ {
+	q.updater = q.updater.Set("attempts", sq.Expr("attempts + 1"))
+	return q
+}
+
+func (q OutboxEventsQ) OrderCreatedAt(ascending bool) OutboxEventsQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("created_at ASC")
+	} else {
+		q.selector = q.selector.OrderBy("created_at DESC")
+	}
+	return q
+}
+
+func (q OutboxEventsQ) Limit(limit uint64) OutboxEventsQ {
+	q.selector = q.selector.Limit(limit)
+	return q
+}
+
+// ForUpdateSkipLocked locks the selected rows until the end of the transaction and skips
+// the rows another transaction has locked.
+func (q OutboxEventsQ) ForUpdateSkipLocked() OutboxEventsQ {
+	q.selector = q.selector.Suffix("FOR UPDATE SKIP LOCKED")
+	return q
+}
+
+func (q OutboxEventsQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", outboxEventsTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q OutboxEventsQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const passwordResetTokensTable = "password_reset_tokens"
+
+type PasswordResetToken struct {
+	ID        uuid.UUID  `db:"id"`
+	AccountID uuid.UUID  `db:"account_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type PasswordResetTokensQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewPasswordResetTokens(db *sql.DB) PasswordResetTokensQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return PasswordResetTokensQ{
+		db:       db,
+		selector: builder.Select("password_reset_tokens.*").From(passwordResetTokensTable),
+		inserter: builder.Insert(passwordResetTokensTable),
+		updater:  builder.Update(passwordResetTokensTable),
+		deleter:  builder.Delete(passwordResetTokensTable),
+		counter:  builder.Select("COUNT(*) AS count").From(passwordResetTokensTable),
+	}
+}
+
+func (q PasswordResetTokensQ) New() PasswordResetTokensQ {
+	return NewPasswordResetTokens(q.db)
+}
+
+func (q PasswordResetTokensQ) Insert(ctx context.Context, input PasswordResetToken) error {
+	values := map[string]interface{}{
+		"id":         input.ID,
+		"account_id": input.AccountID,
+		"token_hash": input.TokenHash,
+		"expires_at": input.ExpiresAt,
+		"used_at":    input.UsedAt,
+		"created_at": input.CreatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", passwordResetTokensTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q PasswordResetTokensQ) Update(ctx context.Context) ([]PasswordResetToken, error) {
+	q.updater = q.updater.Suffix("RETURNING password_reset_tokens.*")
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update query for %s: %w", passwordResetTokensTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []PasswordResetToken
+	for rows.Next() {
+		var t PasswordResetToken
+		err = rows.Scan(
+			&t.ID,
+			&t.AccountID,
+			&t.TokenHash,
+			&t.ExpiresAt,
+			&t.UsedAt,
+			&t.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning updated password reset token: %w", err)
+		}
+		out = append(out, t)
+	}
+
+	return out, nil
+}
+
+func (q PasswordResetTokensQ) UpdateUsedAt(usedAt time.Time) PasswordResetTokensQ {
+	q.updater = q.updater.Set("used_at", usedAt)
+	return q
+}
+
+func (q PasswordResetTokensQ) Get(ctx context.Context) (PasswordResetToken, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return PasswordResetToken{}, fmt.Errorf("building get query for %s: %w", passwordResetTokensTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var t PasswordResetToken
+	err = row.Scan(
+		&t.ID,
+		&t.AccountID,
+		&t.TokenHash,
+		&t.ExpiresAt,
+		&t.UsedAt,
+		&t.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PasswordResetToken{}, nil
+		}
+		return PasswordResetToken{}, err
+	}
+
+	return t, nil
+}
+
+func (q PasswordResetTokensQ) Select(ctx context.Context) ([]PasswordResetToken, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", passwordResetTokensTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []PasswordResetToken
+	for rows.Next() {
+		var t PasswordResetToken
+		err = rows.Scan(
+			&t.ID,
+			&t.AccountID,
+			&t.TokenHash,
+			&t.ExpiresAt,
+			&t.UsedAt,
+			&t.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning password reset token: %w", err)
+		}
+		out = append(out, t)
+	}
+
+	return out, nil
+}
+
+func (q PasswordResetTokensQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", passwordResetTokensTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q PasswordResetTokensQ) FilterID(id uuid.UUID) PasswordResetTokensQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q PasswordResetTokensQ) FilterAccountID(accountID uuid.UUID) PasswordResetTokensQ {
+	q.selector = q.selector.Where(sq.Eq{"account_id": accountID})
+	q.counter = q.counter.Where(sq.Eq{"account_id": accountID})
+	q.deleter = q.deleter.Where(sq.Eq{"account_id": accountID})
+	q.updater = q.updater.Where(sq.Eq{"account_id": accountID})
+	return q
+}
+
+func (q PasswordResetTokensQ) FilterTokenHash(tokenHash string) PasswordResetTokensQ {
+	q.selector = q.selector.Where(sq.Eq{"token_hash": tokenHash})
+	q.counter = q.counter.Where(sq.Eq{"token_hash": tokenHash})
+	q.deleter = q.deleter.Where(sq.Eq{"token_hash": tokenHash})
+	q.updater = q.updater.Where(sq.Eq{"token_hash": tokenHash})
+	return q
+}
+
+func (q PasswordResetTokensQ) FilterUnused() PasswordResetTokensQ {
+	q.selector = q.selector.Where(sq.Eq{"used_at": nil})
+	q.counter = q.counter.Where(sq.Eq{"used_at": nil})
+	q.deleter = q.deleter.Where(sq.Eq{"used_at": nil})
+	q.updater = q.updater.Where(sq.Eq{"used_at": nil})
+	return q
+}
+
+func (q PasswordResetTokensQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", passwordResetTokensTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q PasswordResetTokensQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
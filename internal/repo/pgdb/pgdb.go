@@ -3,6 +3,7 @@ package pgdb
 import (
 	"context"
 	"database/sql"
+	"strings"
 
 	"github.com/umisto/sso-svc/internal/domain/entity"
 )
@@ -16,6 +17,13 @@ func TxFromCtx(ctx context.Context) (*sql.Tx, bool) {
 	return tx, ok
 }
 
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLike makes the value match literally inside a LIKE pattern.
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
 func (a Account) ToEntity() entity.Account {
 	return entity.Account{
 		ID:                a.ID,
@@ -44,14 +52,202 @@ func (ae AccountEmail) ToEntity() entity.AccountEmail {
 		Verified:  ae.Verified,
 		CreatedAt: ae.CreatedAt,
 		UpdatedAt: ae.UpdatedAt,
+
+		VerificationSentAt: ae.VerificationSentAt,
+		EmailChangedAt:     ae.EmailChangedAt,
 	}
 }
 
 func (s Session) ToEntity() entity.Session {
 	return entity.Session{
+		ID:         s.ID,
+		AccountID:  s.AccountID,
+		Generation: s.Generation,
+		LastUsed:   s.LastUsed,
+		CreatedAt:  s.CreatedAt,
+		SessionClient: entity.SessionClient{
+			IP:         s.IP,
+			UserAgent:  s.UserAgent,
+			Device:     s.Device,
+			OS:         s.OS,
+			Browser:    s.Browser,
+			ClientName: s.ClientName,
+			GeoHint:    s.GeoHint,
+
+			OAuthClientID: s.OAuthClientID,
+		},
+	}
+}
+
+func (t PasswordResetToken) ToEntity() entity.PasswordResetToken {
+	return entity.PasswordResetToken{
+		ID:        t.ID,
+		AccountID: t.AccountID,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func (c AccountEmailChange) ToEntity() entity.AccountEmailChange {
+	return entity.AccountEmailChange{
+		AccountID: c.AccountID,
+		NewEmail:  c.NewEmail,
+		ExpiresAt: c.ExpiresAt,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func (c MFAChallenge) ToEntity() entity.MFAChallenge {
+	return entity.MFAChallenge{
+		ID:        c.ID,
+		AccountID: c.AccountID,
+		Attempts:  c.Attempts,
+		ExpiresAt: c.ExpiresAt,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func (m AccountMFA) ToEntity() entity.AccountMFA {
+	return entity.AccountMFA{
+		AccountID:    m.AccountID,
+		TOTPSecret:   m.TOTPSecret,
+		Enabled:      m.Enabled,
+		TOTPLastStep: m.TOTPLastStep,
+		UpdatedAt:    m.UpdatedAt,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+func (c WebAuthnCredential) ToEntity() entity.Passkey {
+	return entity.Passkey{
+		ID:              c.ID,
+		AccountID:       c.AccountID,
+		CredentialID:    c.CredentialID,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Transports:      c.Transports,
+		SignCount:       uint32(c.SignCount),
+		AAGUID:          c.AAGUID,
+		BackupEligible:  c.BackupEligible,
+		BackupState:     c.BackupState,
+		Name:            c.Name,
+		LastUsedAt:      c.LastUsedAt,
+		CreatedAt:       c.CreatedAt,
+	}
+}
+
+func (s WebAuthnSession) ToEntity() entity.PasskeyCeremony {
+	return entity.PasskeyCeremony{
 		ID:        s.ID,
-		AccountID: s.AccountID,
-		LastUsed:  s.LastUsed,
+		AccountID: s.AccountID.UUID,
+		Kind:      s.Kind,
+		Session:   s.Data,
+		ExpiresAt: s.ExpiresAt,
 		CreatedAt: s.CreatedAt,
 	}
 }
+
+func (t LoginThrottle) ToEntity() entity.LoginThrottle {
+	return entity.LoginThrottle{
+		Kind:           t.Kind,
+		Subject:        t.Subject,
+		FailedAttempts: t.FailedAttempts,
+		Lockouts:       t.Lockouts,
+		LockedUntil:    t.LockedUntil,
+		LastFailedAt:   t.LastFailedAt,
+	}
+}
+
+func (k SigningKey) ToEntity() entity.SigningKey {
+	return entity.SigningKey{
+		ID:         k.ID,
+		Algorithm:  k.Algorithm,
+		PrivateKey: k.PrivateKey,
+		Status:     k.Status,
+		ExpiresAt:  k.ExpiresAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
+
+func (c OAuthClient) ToEntity() entity.OAuthClient {
+	client := entity.OAuthClient{
+		ID:           c.ID,
+		Name:         c.Name,
+		RedirectURIs: c.RedirectURIs,
+		Scopes:       c.Scopes,
+		SkipConsent:  c.SkipConsent,
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
+	}
+	if c.SecretHash != nil {
+		client.SecretHash = *c.SecretHash
+	}
+
+	return client
+}
+
+func (c OAuthAuthorizationCode) ToEntity() entity.OAuthAuthorizationCode {
+	return entity.OAuthAuthorizationCode{
+		CodeHash:            c.CodeHash,
+		ClientID:            c.ClientID,
+		AccountID:           c.AccountID,
+		RedirectURI:         c.RedirectURI,
+		Scopes:              c.Scopes,
+		Nonce:               c.Nonce,
+		CodeChallenge:       c.CodeChallenge,
+		CodeChallengeMethod: c.CodeChallengeMethod,
+		AuthTime:            c.AuthTime,
+		ExpiresAt:           c.ExpiresAt,
+		CreatedAt:           c.CreatedAt,
+	}
+}
+
+func (c OAuthConsent) ToEntity() entity.OAuthConsent {
+	return entity.OAuthConsent{
+		AccountID: c.AccountID,
+		ClientID:  c.ClientID,
+		Scopes:    c.Scopes,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func (i AccountIdentity) ToEntity() entity.AccountIdentity {
+	return entity.AccountIdentity{
+		ID:        i.ID,
+		AccountID: i.AccountID,
+		Provider:  i.Provider,
+		Subject:   i.Subject,
+		Email:     i.Email,
+		LinkedAt:  i.LinkedAt,
+	}
+}
+
+func (s SocialLoginState) ToEntity() entity.SocialLoginState {
+	return entity.SocialLoginState{
+		StateHash:    s.StateHash,
+		Provider:     s.Provider,
+		Purpose:      s.Purpose,
+		AccountID:    s.AccountID.UUID,
+		SessionID:    s.SessionID.UUID,
+		CodeVerifier: s.CodeVerifier,
+		Nonce:        s.Nonce,
+		RedirectURI:  s.RedirectURI,
+		ExpiresAt:    s.ExpiresAt,
+		CreatedAt:    s.CreatedAt,
+	}
+}
+
+func (e AuditLogEntry) ToEntity() entity.AuditLogEntry {
+	return entity.AuditLogEntry{
+		ID:        e.ID,
+		ActorID:   e.ActorID.UUID,
+		TargetID:  e.TargetID.UUID,
+		Action:    e.Action,
+		IP:        e.IP,
+		UserAgent: e.UserAgent,
+		Metadata:  e.Metadata,
+		CreatedAt: e.CreatedAt,
+	}
+}
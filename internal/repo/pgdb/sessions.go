@@ -14,11 +14,21 @@ import (
 const sessionsTable = "sessions"
 
 type Session struct {
-	ID        uuid.UUID `db:"id"`
-	AccountID uuid.UUID `db:"account_id"`
-	HashToken string    `db:"hash_token"`
-	LastUsed  time.Time `db:"last_used"`
-	CreatedAt time.Time `db:"created_at"`
+	ID         uuid.UUID `db:"id"`
+	AccountID  uuid.UUID `db:"account_id"`
+	HashToken  string    `db:"hash_token"`
+	LastUsed   time.Time `db:"last_used"`
+	CreatedAt  time.Time `db:"created_at"`
+	Generation int64     `db:"generation"`
+	IP         string    `db:"ip"`
+	UserAgent  string    `db:"user_agent"`
+	Device     string    `db:"device"`
+	OS         string    `db:"os"`
+	Browser    string    `db:"browser"`
+	ClientName string    `db:"client_name"`
+	GeoHint    string    `db:"geo_hint"`
+	// OAuthClientID is the oauth client the session was opened for, empty for our own front-ends.
+	OAuthClientID string `db:"oauth_client_id"`
 }
 
 type SessionsQ struct {
@@ -48,11 +58,21 @@ func (q SessionsQ) New() SessionsQ {
 
 func (q SessionsQ) Insert(ctx context.Context, input Session) error {
 	values := map[string]interface{}{
-		"id":         input.ID,
-		"account_id": input.AccountID,
-		"hash_token": input.HashToken,
-		"last_used":  input.LastUsed,
-		"created_at": input.CreatedAt,
+		"id":          input.ID,
+		"account_id":  input.AccountID,
+		"hash_token":  input.HashToken,
+		"last_used":   input.LastUsed,
+		"created_at":  input.CreatedAt,
+		"generation":  input.Generation,
+		"ip":          input.IP,
+		"user_agent":  input.UserAgent,
+		"device":      input.Device,
+		"os":          input.OS,
+		"browser":     input.Browser,
+		"client_name": input.ClientName,
+		"geo_hint":    input.GeoHint,
+
+		"oauth_client_id": input.OAuthClientID,
 	}
 
 	query, args, err := q.inserter.SetMap(values).ToSql()
@@ -98,6 +118,15 @@ func (q SessionsQ) Update(ctx context.Context) ([]Session, error) {
 			&s.HashToken,
 			&s.LastUsed,
 			&s.CreatedAt,
+			&s.Generation,
+			&s.IP,
+			&s.UserAgent,
+			&s.Device,
+			&s.OS,
+			&s.Browser,
+			&s.ClientName,
+			&s.GeoHint,
+			&s.OAuthClientID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scanning updated session: %w", err)
@@ -113,6 +142,17 @@ func (q SessionsQ) UpdateToken(token string) SessionsQ {
 	return q
 }
 
+// IncrementGeneration bumps the rotation counter of the refresh token.
+func (q SessionsQ) IncrementGeneration() SessionsQ {
+	q.updater = q.updater.Set("generation", sq.Expr("generation + 1"))
+	return q
+}
+
+func (q SessionsQ) UpdateIP(ip string) SessionsQ {
+	q.updater = q.updater.Set("ip", ip)
+	return q
+}
+
 func (q SessionsQ) UpdateLastUsed(lastUsed time.Time) SessionsQ {
 	q.updater = q.updater.Set("last_used", lastUsed)
 	return q
@@ -135,8 +175,17 @@ func (q SessionsQ) Get(ctx context.Context) (Session, error) {
 		&sess.ID,
 		&sess.AccountID,
 		&sess.HashToken,
-		&sess.CreatedAt,
 		&sess.LastUsed,
+		&sess.CreatedAt,
+		&sess.Generation,
+		&sess.IP,
+		&sess.UserAgent,
+		&sess.Device,
+		&sess.OS,
+		&sess.Browser,
+		&sess.ClientName,
+		&sess.GeoHint,
+		&sess.OAuthClientID,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -174,8 +223,17 @@ func (q SessionsQ) Select(ctx context.Context) ([]Session, error) {
 			&sess.ID,
 			&sess.AccountID,
 			&sess.HashToken,
-			&sess.CreatedAt,
 			&sess.LastUsed,
+			&sess.CreatedAt,
+			&sess.Generation,
+			&sess.IP,
+			&sess.UserAgent,
+			&sess.Device,
+			&sess.OS,
+			&sess.Browser,
+			&sess.ClientName,
+			&sess.GeoHint,
+			&sess.OAuthClientID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scanning session row: %w", err)
@@ -218,6 +276,82 @@ func (q SessionsQ) FilterAccountID(accountID uuid.UUID) SessionsQ {
 	return q
 }
 
+func (q SessionsQ) FilterIDs(IDs []uuid.UUID) SessionsQ {
+	q.selector = q.selector.Where(sq.Eq{"id": IDs})
+	q.deleter = q.deleter.Where(sq.Eq{"id": IDs})
+	q.updater = q.updater.Where(sq.Eq{"id": IDs})
+	q.counter = q.counter.Where(sq.Eq{"id": IDs})
+
+	return q
+}
+
+// FilterExpired selects the sessions last used before lastUsedBefore or created before
+// createdBefore, a nil time does not filter.
+func (q SessionsQ) FilterExpired(lastUsedBefore, createdBefore *time.Time) SessionsQ {
+	cond := sq.Or{}
+	if lastUsedBefore != nil {
+		cond = append(cond, sq.Lt{"last_used": *lastUsedBefore})
+	}
+	if createdBefore != nil {
+		cond = append(cond, sq.Lt{"created_at": *createdBefore})
+	}
+
+	q.selector = q.selector.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	q.counter = q.counter.Where(cond)
+
+	return q
+}
+
+// ForUpdateSkipLocked locks the selected rows until the end of the transaction and skips
+// the rows another transaction has locked.
+func (q SessionsQ) ForUpdateSkipLocked() SessionsQ {
+	q.selector = q.selector.Suffix("FOR UPDATE SKIP LOCKED")
+	return q
+}
+
+func (q SessionsQ) FilterNotID(ID uuid.UUID) SessionsQ {
+	q.selector = q.selector.Where(sq.NotEq{"id": ID})
+	q.deleter = q.deleter.Where(sq.NotEq{"id": ID})
+	q.updater = q.updater.Where(sq.NotEq{"id": ID})
+	q.counter = q.counter.Where(sq.NotEq{"id": ID})
+
+	return q
+}
+
+func (q SessionsQ) FilterAccountRole(role string) SessionsQ {
+	cond := sq.Expr(
+		"account_id IN (?)",
+		sq.Select("id").From("accounts").Where(sq.Eq{"role": role}),
+	)
+
+	q.selector = q.selector.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	q.counter = q.counter.Where(cond)
+
+	return q
+}
+
+func (q SessionsQ) FilterCreatedBefore(t time.Time) SessionsQ {
+	q.selector = q.selector.Where(sq.Lt{"created_at": t})
+	q.deleter = q.deleter.Where(sq.Lt{"created_at": t})
+	q.updater = q.updater.Where(sq.Lt{"created_at": t})
+	q.counter = q.counter.Where(sq.Lt{"created_at": t})
+
+	return q
+}
+
+func (q SessionsQ) FilterGeneration(generation int64) SessionsQ {
+	q.selector = q.selector.Where(sq.Eq{"generation": generation})
+	q.deleter = q.deleter.Where(sq.Eq{"generation": generation})
+	q.updater = q.updater.Where(sq.Eq{"generation": generation})
+	q.counter = q.counter.Where(sq.Eq{"generation": generation})
+
+	return q
+}
+
 func (q SessionsQ) OrderCreatedAt(ascending bool) SessionsQ {
 	if ascending {
 		q.selector = q.selector.OrderBy("created_at ASC")
@@ -227,6 +361,15 @@ func (q SessionsQ) OrderCreatedAt(ascending bool) SessionsQ {
 	return q
 }
 
+func (q SessionsQ) OrderLastUsed(ascending bool) SessionsQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("last_used ASC")
+	} else {
+		q.selector = q.selector.OrderBy("last_used DESC")
+	}
+	return q
+}
+
 func (q SessionsQ) Count(ctx context.Context) (uint64, error) {
 	query, args, err := q.counter.ToSql()
 	if err != nil {
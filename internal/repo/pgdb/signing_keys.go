@@ -0,0 +1,298 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+const signingKeysTable = "signing_keys"
+
+type SigningKey struct {
+	ID         string     `db:"id"`
+	Algorithm  string     `db:"algorithm"`
+	PrivateKey string     `db:"private_key"`
+	Status     string     `db:"status"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+type SigningKeysQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewSigningKeys(db *sql.DB) SigningKeysQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return SigningKeysQ{
+		db:       db,
+		selector: builder.Select("signing_keys.*").From(signingKeysTable),
+		inserter: builder.Insert(signingKeysTable),
+		updater:  builder.Update(signingKeysTable),
+		deleter:  builder.Delete(signingKeysTable),
+		counter:  builder.Select("COUNT(*) AS count").From(signingKeysTable),
+	}
+}
+
+func (q SigningKeysQ) New() SigningKeysQ {
+	return NewSigningKeys(q.db)
+}
+
+func (q SigningKeysQ) Insert(ctx context.Context, input SigningKey) error {
+	values := map[string]interface{}{
+		"id":          input.ID,
+		"algorithm":   input.Algorithm,
+		"private_key": input.PrivateKey,
+		"status":      input.Status,
+		"expires_at":  input.ExpiresAt,
+		"created_at":  input.CreatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", signingKeysTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q SigningKeysQ) Update(ctx context.Context) ([]SigningKey, error) {
+	q.updater = q.updater.Suffix("RETURNING signing_keys.*")
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update query for %s: %w", signingKeysTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []SigningKey
+	for rows.Next() {
+		var k SigningKey
+		err = rows.Scan(
+			&k.ID,
+			&k.Algorithm,
+			&k.PrivateKey,
+			&k.Status,
+			&k.ExpiresAt,
+			&k.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning updated signing key: %w", err)
+		}
+		out = append(out, k)
+	}
+
+	return out, nil
+}
+
+func (q SigningKeysQ) UpdateStatus(status string) SigningKeysQ {
+	q.updater = q.updater.Set("status", status)
+	return q
+}
+
+func (q SigningKeysQ) UpdateExpiresAt(expiresAt *time.Time) SigningKeysQ {
+	q.updater = q.updater.Set("expires_at", expiresAt)
+	return q
+}
+
+func (q SigningKeysQ) Get(ctx context.Context) (SigningKey, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("building get query for %s: %w", signingKeysTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var k SigningKey
+	err = row.Scan(
+		&k.ID,
+		&k.Algorithm,
+		&k.PrivateKey,
+		&k.Status,
+		&k.ExpiresAt,
+		&k.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return SigningKey{}, nil
+		}
+		return SigningKey{}, err
+	}
+
+	return k, nil
+}
+
+func (q SigningKeysQ) Select(ctx context.Context) ([]SigningKey, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", signingKeysTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []SigningKey
+	for rows.Next() {
+		var k SigningKey
+		err = rows.Scan(
+			&k.ID,
+			&k.Algorithm,
+			&k.PrivateKey,
+			&k.Status,
+			&k.ExpiresAt,
+			&k.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning signing key: %w", err)
+		}
+		out = append(out, k)
+	}
+
+	return out, nil
+}
+
+func (q SigningKeysQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", signingKeysTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q SigningKeysQ) FilterID(id string) SigningKeysQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q SigningKeysQ) FilterStatus(status string) SigningKeysQ {
+	q.selector = q.selector.Where(sq.Eq{"status": status})
+	q.counter = q.counter.Where(sq.Eq{"status": status})
+	q.deleter = q.deleter.Where(sq.Eq{"status": status})
+	q.updater = q.updater.Where(sq.Eq{"status": status})
+	return q
+}
+
+func (q SigningKeysQ) FilterExpiredBefore(t time.Time) SigningKeysQ {
+	q.selector = q.selector.Where(sq.Lt{"expires_at": t})
+	q.counter = q.counter.Where(sq.Lt{"expires_at": t})
+	q.deleter = q.deleter.Where(sq.Lt{"expires_at": t})
+	q.updater = q.updater.Where(sq.Lt{"expires_at": t})
+	return q
+}
+
+// FilterValidAt keeps the keys that have not expired at t.
+func (q SigningKeysQ) FilterValidAt(t time.Time) SigningKeysQ {
+	cond := sq.Or{sq.Eq{"expires_at": nil}, sq.Gt{"expires_at": t}}
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	return q
+}
+
+func (q SigningKeysQ) OrderCreatedAt(ascending bool) SigningKeysQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("created_at ASC")
+	} else {
+		q.selector = q.selector.OrderBy("created_at DESC")
+	}
+	return q
+}
+
+func (q SigningKeysQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", signingKeysTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q SigningKeysQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
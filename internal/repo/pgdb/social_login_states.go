@@ -0,0 +1,204 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const socialLoginStatesTable = "social_login_states"
+
+type SocialLoginState struct {
+	StateHash    string        `db:"state_hash"`
+	Provider     string        `db:"provider"`
+	Purpose      string        `db:"purpose"`
+	AccountID    uuid.NullUUID `db:"account_id"`
+	SessionID    uuid.NullUUID `db:"session_id"`
+	CodeVerifier string        `db:"code_verifier"`
+	Nonce        string        `db:"nonce"`
+	RedirectURI  string        `db:"redirect_uri"`
+	ExpiresAt    time.Time     `db:"expires_at"`
+	CreatedAt    time.Time     `db:"created_at"`
+}
+
+type SocialLoginStatesQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewSocialLoginStates(db *sql.DB) SocialLoginStatesQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return SocialLoginStatesQ{
+		db:       db,
+		selector: builder.Select("social_login_states.*").From(socialLoginStatesTable),
+		inserter: builder.Insert(socialLoginStatesTable),
+		updater:  builder.Update(socialLoginStatesTable),
+		deleter:  builder.Delete(socialLoginStatesTable),
+		counter:  builder.Select("COUNT(*) AS count").From(socialLoginStatesTable),
+	}
+}
+
+func (q SocialLoginStatesQ) New() SocialLoginStatesQ {
+	return NewSocialLoginStates(q.db)
+}
+
+func (q SocialLoginStatesQ) Insert(ctx context.Context, input SocialLoginState) error {
+	values := map[string]interface{}{
+		"state_hash":    input.StateHash,
+		"provider":      input.Provider,
+		"purpose":       input.Purpose,
+		"account_id":    input.AccountID,
+		"session_id":    input.SessionID,
+		"code_verifier": input.CodeVerifier,
+		"nonce":         input.Nonce,
+		"redirect_uri":  input.RedirectURI,
+		"expires_at":    input.ExpiresAt,
+		"created_at":    input.CreatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", socialLoginStatesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q SocialLoginStatesQ) Get(ctx context.Context) (SocialLoginState, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return SocialLoginState{}, fmt.Errorf("building get query for %s: %w", socialLoginStatesTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var s SocialLoginState
+	err = row.Scan(
+		&s.StateHash,
+		&s.Provider,
+		&s.Purpose,
+		&s.AccountID,
+		&s.SessionID,
+		&s.CodeVerifier,
+		&s.Nonce,
+		&s.RedirectURI,
+		&s.ExpiresAt,
+		&s.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return SocialLoginState{}, nil
+		}
+		return SocialLoginState{}, err
+	}
+
+	return s, nil
+}
+
+func (q SocialLoginStatesQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", socialLoginStatesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q SocialLoginStatesQ) FilterStateHash(stateHash string) SocialLoginStatesQ {
+	q.selector = q.selector.Where(sq.Eq{"state_hash": stateHash})
+	q.counter = q.counter.Where(sq.Eq{"state_hash": stateHash})
+	q.deleter = q.deleter.Where(sq.Eq{"state_hash": stateHash})
+	q.updater = q.updater.Where(sq.Eq{"state_hash": stateHash})
+	return q
+}
+
+func (q SocialLoginStatesQ) FilterExpiredBefore(t time.Time) SocialLoginStatesQ {
+	q.selector = q.selector.Where(sq.Lt{"expires_at": t})
+	q.counter = q.counter.Where(sq.Lt{"expires_at": t})
+	q.deleter = q.deleter.Where(sq.Lt{"expires_at": t})
+	q.updater = q.updater.Where(sq.Lt{"expires_at": t})
+	return q
+}
+
+// ForUpdate locks the selected rows until the end of the transaction.
+func (q SocialLoginStatesQ) ForUpdate() SocialLoginStatesQ {
+	q.selector = q.selector.Suffix("FOR UPDATE")
+	return q
+}
+
+func (q SocialLoginStatesQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", socialLoginStatesTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q SocialLoginStatesQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,321 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const webauthnCredentialsTable = "webauthn_credentials"
+
+type WebAuthnCredential struct {
+	ID              uuid.UUID  `db:"id"`
+	AccountID       uuid.UUID  `db:"account_id"`
+	CredentialID    []byte     `db:"credential_id"`
+	PublicKey       []byte     `db:"public_key"`
+	AttestationType string     `db:"attestation_type"`
+	Transports      []string   `db:"transports"`
+	SignCount       int64      `db:"sign_count"`
+	AAGUID          []byte     `db:"aaguid"`
+	BackupEligible  bool       `db:"backup_eligible"`
+	BackupState     bool       `db:"backup_state"`
+	Name            string     `db:"name"`
+	LastUsedAt      *time.Time `db:"last_used_at"`
+	CreatedAt       time.Time  `db:"created_at"`
+}
+
+type WebAuthnCredentialsQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewWebAuthnCredentials(db *sql.DB) WebAuthnCredentialsQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return WebAuthnCredentialsQ{
+		db:       db,
+		selector: builder.Select("webauthn_credentials.*").From(webauthnCredentialsTable),
+		inserter: builder.Insert(webauthnCredentialsTable),
+		updater:  builder.Update(webauthnCredentialsTable),
+		deleter:  builder.Delete(webauthnCredentialsTable),
+		counter:  builder.Select("COUNT(*) AS count").From(webauthnCredentialsTable),
+	}
+}
+
+func (q WebAuthnCredentialsQ) New() WebAuthnCredentialsQ {
+	return NewWebAuthnCredentials(q.db)
+}
+
+func (q WebAuthnCredentialsQ) Insert(ctx context.Context, input WebAuthnCredential) error {
+	values := map[string]interface{}{
+		"id":               input.ID,
+		"account_id":       input.AccountID,
+		"credential_id":    input.CredentialID,
+		"public_key":       input.PublicKey,
+		"attestation_type": input.AttestationType,
+		"transports":       pq.Array(input.Transports),
+		"sign_count":       input.SignCount,
+		"aaguid":           input.AAGUID,
+		"backup_eligible":  input.BackupEligible,
+		"backup_state":     input.BackupState,
+		"name":             input.Name,
+		"last_used_at":     input.LastUsedAt,
+		"created_at":       input.CreatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", webauthnCredentialsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q WebAuthnCredentialsQ) Update(ctx context.Context) ([]WebAuthnCredential, error) {
+	q.updater = q.updater.Suffix("RETURNING webauthn_credentials.*")
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update query for %s: %w", webauthnCredentialsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []WebAuthnCredential
+	for rows.Next() {
+		var c WebAuthnCredential
+		err = rows.Scan(
+			&c.ID,
+			&c.AccountID,
+			&c.CredentialID,
+			&c.PublicKey,
+			&c.AttestationType,
+			pq.Array(&c.Transports),
+			&c.SignCount,
+			&c.AAGUID,
+			&c.BackupEligible,
+			&c.BackupState,
+			&c.Name,
+			&c.LastUsedAt,
+			&c.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning updated webauthn credential: %w", err)
+		}
+		out = append(out, c)
+	}
+
+	return out, nil
+}
+
+func (q WebAuthnCredentialsQ) UpdateSignCount(signCount int64) WebAuthnCredentialsQ {
+	q.updater = q.updater.Set("sign_count", signCount)
+	return q
+}
+
+func (q WebAuthnCredentialsQ) UpdateBackupState(backupState bool) WebAuthnCredentialsQ {
+	q.updater = q.updater.Set("backup_state", backupState)
+	return q
+}
+
+func (q WebAuthnCredentialsQ) UpdateLastUsedAt(lastUsedAt time.Time) WebAuthnCredentialsQ {
+	q.updater = q.updater.Set("last_used_at", lastUsedAt)
+	return q
+}
+
+func (q WebAuthnCredentialsQ) Get(ctx context.Context) (WebAuthnCredential, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return WebAuthnCredential{}, fmt.Errorf("building get query for %s: %w", webauthnCredentialsTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var c WebAuthnCredential
+	err = row.Scan(
+		&c.ID,
+		&c.AccountID,
+		&c.CredentialID,
+		&c.PublicKey,
+		&c.AttestationType,
+		pq.Array(&c.Transports),
+		&c.SignCount,
+		&c.AAGUID,
+		&c.BackupEligible,
+		&c.BackupState,
+		&c.Name,
+		&c.LastUsedAt,
+		&c.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return WebAuthnCredential{}, nil
+		}
+		return WebAuthnCredential{}, err
+	}
+
+	return c, nil
+}
+
+func (q WebAuthnCredentialsQ) Select(ctx context.Context) ([]WebAuthnCredential, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", webauthnCredentialsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []WebAuthnCredential
+	for rows.Next() {
+		var c WebAuthnCredential
+		err = rows.Scan(
+			&c.ID,
+			&c.AccountID,
+			&c.CredentialID,
+			&c.PublicKey,
+			&c.AttestationType,
+			pq.Array(&c.Transports),
+			&c.SignCount,
+			&c.AAGUID,
+			&c.BackupEligible,
+			&c.BackupState,
+			&c.Name,
+			&c.LastUsedAt,
+			&c.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning webauthn credential: %w", err)
+		}
+		out = append(out, c)
+	}
+
+	return out, nil
+}
+
+func (q WebAuthnCredentialsQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", webauthnCredentialsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q WebAuthnCredentialsQ) FilterID(id uuid.UUID) WebAuthnCredentialsQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q WebAuthnCredentialsQ) FilterAccountID(accountID uuid.UUID) WebAuthnCredentialsQ {
+	q.selector = q.selector.Where(sq.Eq{"account_id": accountID})
+	q.counter = q.counter.Where(sq.Eq{"account_id": accountID})
+	q.deleter = q.deleter.Where(sq.Eq{"account_id": accountID})
+	q.updater = q.updater.Where(sq.Eq{"account_id": accountID})
+	return q
+}
+
+func (q WebAuthnCredentialsQ) FilterCredentialID(credentialID []byte) WebAuthnCredentialsQ {
+	q.selector = q.selector.Where(sq.Eq{"credential_id": credentialID})
+	q.counter = q.counter.Where(sq.Eq{"credential_id": credentialID})
+	q.deleter = q.deleter.Where(sq.Eq{"credential_id": credentialID})
+	q.updater = q.updater.Where(sq.Eq{"credential_id": credentialID})
+	return q
+}
+
+func (q WebAuthnCredentialsQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", webauthnCredentialsTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q WebAuthnCredentialsQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const webauthnSessionsTable = "webauthn_sessions"
+
+type WebAuthnSession struct {
+	ID        uuid.UUID     `db:"id"`
+	AccountID uuid.NullUUID `db:"account_id"`
+	Kind      string        `db:"kind"`
+	Data      []byte        `db:"data"`
+	ExpiresAt time.Time     `db:"expires_at"`
+	CreatedAt time.Time     `db:"created_at"`
+}
+
+type WebAuthnSessionsQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewWebAuthnSessions(db *sql.DB) WebAuthnSessionsQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return WebAuthnSessionsQ{
+		db:       db,
+		selector: builder.Select("webauthn_sessions.*").From(webauthnSessionsTable),
+		inserter: builder.Insert(webauthnSessionsTable),
+		updater:  builder.Update(webauthnSessionsTable),
+		deleter:  builder.Delete(webauthnSessionsTable),
+		counter:  builder.Select("COUNT(*) AS count").From(webauthnSessionsTable),
+	}
+}
+
+func (q WebAuthnSessionsQ) New() WebAuthnSessionsQ {
+	return NewWebAuthnSessions(q.db)
+}
+
+func (q WebAuthnSessionsQ) Insert(ctx context.Context, input WebAuthnSession) error {
+	values := map[string]interface{}{
+		"id":         input.ID,
+		"account_id": input.AccountID,
+		"kind":       input.Kind,
+		"data":       input.Data,
+		"expires_at": input.ExpiresAt,
+		"created_at": input.CreatedAt,
+	}
+
+	query, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", webauthnSessionsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q WebAuthnSessionsQ) Get(ctx context.Context) (WebAuthnSession, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return WebAuthnSession{}, fmt.Errorf("building get query for %s: %w", webauthnSessionsTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var s WebAuthnSession
+	err = row.Scan(
+		&s.ID,
+		&s.AccountID,
+		&s.Kind,
+		&s.Data,
+		&s.ExpiresAt,
+		&s.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return WebAuthnSession{}, nil
+		}
+		return WebAuthnSession{}, err
+	}
+
+	return s, nil
+}
+
+func (q WebAuthnSessionsQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", webauthnSessionsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q WebAuthnSessionsQ) FilterID(id uuid.UUID) WebAuthnSessionsQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q WebAuthnSessionsQ) FilterKind(kind string) WebAuthnSessionsQ {
+	q.selector = q.selector.Where(sq.Eq{"kind": kind})
+	q.counter = q.counter.Where(sq.Eq{"kind": kind})
+	q.deleter = q.deleter.Where(sq.Eq{"kind": kind})
+	q.updater = q.updater.Where(sq.Eq{"kind": kind})
+	return q
+}
+
+func (q WebAuthnSessionsQ) FilterExpiredBefore(t time.Time) WebAuthnSessionsQ {
+	q.selector = q.selector.Where(sq.Lt{"expires_at": t})
+	q.counter = q.counter.Where(sq.Lt{"expires_at": t})
+	q.deleter = q.deleter.Where(sq.Lt{"expires_at": t})
+	q.updater = q.updater.Where(sq.Lt{"expires_at": t})
+	return q
+}
+
+// ForUpdate locks the selected rows until the end of the transaction.
+func (q WebAuthnSessionsQ) ForUpdate() WebAuthnSessionsQ {
+	q.selector = q.selector.Suffix("FOR UPDATE")
+	return q
+}
+
+func (q WebAuthnSessionsQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", webauthnSessionsTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q WebAuthnSessionsQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	_, ok := TxFromCtx(ctx)
+	if ok {
+		return fn(ctx)
+	}
+
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+
+	if err = fn(ctxWithTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package repo
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/umisto/sso-svc/internal/repo/pgdb"
@@ -15,6 +16,24 @@ type sqlDB struct {
 	emails    pgdb.AccountEmailsQ
 	passwords pgdb.AccountPasswordsQ
 	sessions  pgdb.SessionsQ
+
+	passwordResetTokens pgdb.PasswordResetTokensQ
+	emailChanges        pgdb.AccountEmailChangesQ
+	mfa                 pgdb.AccountMFAQ
+	mfaRecoveryCodes    pgdb.AccountMFARecoveryCodesQ
+	mfaChallenges       pgdb.MFAChallengesQ
+	passkeys            pgdb.WebAuthnCredentialsQ
+	passkeySessions     pgdb.WebAuthnSessionsQ
+	loginThrottles      pgdb.LoginThrottlesQ
+	signingKeys         pgdb.SigningKeysQ
+	oauthClients        pgdb.OAuthClientsQ
+	oauthCodes          pgdb.OAuthAuthorizationCodesQ
+	oauthConsents       pgdb.OAuthConsentsQ
+	identities          pgdb.AccountIdentitiesQ
+	socialStates        pgdb.SocialLoginStatesQ
+	auditLog            pgdb.AuditLogQ
+	outbox              pgdb.OutboxEventsQ
+	inbox               pgdb.InboxEventsQ
 }
 
 func New(db *sql.DB) *Repository {
@@ -24,6 +43,30 @@ func New(db *sql.DB) *Repository {
 			sessions:  pgdb.NewSessions(db),
 			emails:    pgdb.NewAccountEmails(db),
 			passwords: pgdb.NewAccountPasswords(db),
+
+			passwordResetTokens: pgdb.NewPasswordResetTokens(db),
+			emailChanges:        pgdb.NewAccountEmailChanges(db),
+			mfa:                 pgdb.NewAccountMFA(db),
+			mfaRecoveryCodes:    pgdb.NewAccountMFARecoveryCodes(db),
+			mfaChallenges:       pgdb.NewMFAChallenges(db),
+			passkeys:            pgdb.NewWebAuthnCredentials(db),
+			passkeySessions:     pgdb.NewWebAuthnSessions(db),
+			loginThrottles:      pgdb.NewLoginThrottles(db),
+			signingKeys:         pgdb.NewSigningKeys(db),
+			oauthClients:        pgdb.NewOAuthClients(db),
+			oauthCodes:          pgdb.NewOAuthAuthorizationCodes(db),
+			oauthConsents:       pgdb.NewOAuthConsents(db),
+			identities:          pgdb.NewAccountIdentities(db),
+			socialStates:        pgdb.NewSocialLoginStates(db),
+			auditLog:            pgdb.NewAuditLog(db),
+			outbox:              pgdb.NewOutboxEvents(db),
+			inbox:               pgdb.NewInboxEvents(db),
 		},
 	}
 }
+
+// Transaction runs fn in one transaction, the queries made with the context fn gets
+// join it.
+func (r *Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.sql.accounts.Transaction(ctx, fn)
+}
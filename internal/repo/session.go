@@ -10,18 +10,33 @@ import (
 	"github.com/google/uuid"
 	"github.com/umisto/restkit/pagi"
 	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
 	"github.com/umisto/sso-svc/internal/repo/pgdb"
 )
 
-func (r *Repository) CreateSession(ctx context.Context, sessionID, accountID uuid.UUID, hashToken string) (entity.Session, error) {
+func (r *Repository) CreateSession(
+	ctx context.Context,
+	sessionID, accountID uuid.UUID,
+	hashToken string,
+	client entity.SessionClient,
+) (entity.Session, error) {
 	now := time.Now().UTC()
 
 	row := pgdb.Session{
-		ID:        sessionID,
-		AccountID: accountID,
-		HashToken: hashToken,
-		LastUsed:  now,
-		CreatedAt: now,
+		ID:         sessionID,
+		AccountID:  accountID,
+		HashToken:  hashToken,
+		LastUsed:   now,
+		CreatedAt:  now,
+		IP:         client.IP,
+		UserAgent:  client.UserAgent,
+		Device:     client.Device,
+		OS:         client.OS,
+		Browser:    client.Browser,
+		ClientName: client.ClientName,
+		GeoHint:    client.GeoHint,
+
+		OAuthClientID: client.OAuthClientID,
 	}
 
 	err := r.sql.sessions.Insert(ctx, row)
@@ -103,22 +118,60 @@ func (r *Repository) GetSessionToken(ctx context.Context, sessionID uuid.UUID) (
 	return row.HashToken, nil
 }
 
-func (r *Repository) UpdateSessionToken(ctx context.Context, sessionID uuid.UUID, token string) (entity.Session, error) {
-	sess, err := r.sql.sessions.New().
+// RotateSessionToken stores the next refresh token only if the session is still at the
+// given generation, a zero session is returned when another rotation got there first.
+// A non empty ip replaces the address of the session.
+func (r *Repository) RotateSessionToken(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	generation int64,
+	token string,
+	ip string,
+) (entity.Session, error) {
+	query := r.sql.sessions.New().
 		FilterID(sessionID).
+		FilterGeneration(generation).
 		UpdateToken(token).
-		Update(ctx)
-	switch {
-	case errors.Is(err, sql.ErrNoRows):
-		return entity.Session{}, nil
-	case err != nil:
+		IncrementGeneration()
+	if ip != "" {
+		query = query.UpdateIP(ip)
+	}
+
+	sess, err := query.Update(ctx)
+	if err != nil {
 		return entity.Session{}, err
 	}
 
-	if len(sess) != 1 {
+	switch len(sess) {
+	case 0:
+		return entity.Session{}, nil
+	case 1:
+		return sess[0].ToEntity(), nil
+	default:
 		return entity.Session{}, fmt.Errorf("expected 1 session, got %d", len(sess))
 	}
-	return sess[0].ToEntity(), nil
+}
+
+// LockAccountSessions locks the account row until the end of the transaction and returns
+// its sessions from the least recently used. Logins of the account running at the same
+// time wait for the lock, so they see each other's sessions. Call it within Transaction.
+func (r *Repository) LockAccountSessions(ctx context.Context, accountID uuid.UUID) ([]entity.Session, error) {
+	_, err := r.sql.accounts.New().FilterID(accountID).ForUpdate().Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.sql.sessions.New().FilterAccountID(accountID).OrderLastUsed(true).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]entity.Session, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, row.ToEntity())
+	}
+
+	return sessions, nil
 }
 
 func (r *Repository) DeleteSession(ctx context.Context, sessionID uuid.UUID) error {
@@ -136,6 +189,73 @@ func (r *Repository) DeleteAccountSession(ctx context.Context, userID, sessionID
 		Delete(ctx)
 }
 
+// DeleteSessions revokes every session matching the filter and returns how many were
+// revoked.
+func (r *Repository) DeleteSessions(ctx context.Context, filter auth.SessionsFilter) (int64, error) {
+	query := r.sql.sessions.New()
+	if filter.Role != "" {
+		query = query.FilterAccountRole(filter.Role)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.FilterCreatedBefore(*filter.CreatedBefore)
+	}
+	if filter.ExceptSessionID != uuid.Nil {
+		query = query.FilterNotID(filter.ExceptSessionID)
+	}
+
+	var total uint64
+	err := r.sql.sessions.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if total, err = query.Count(ctx); err != nil {
+			return err
+		}
+
+		return query.Delete(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(total), nil
+}
+
+// DeleteExpiredSessions deletes up to limit sessions last used before lastUsedBefore or
+// created before createdBefore and returns them. Sessions locked by a concurrent sweep
+// are skipped, so several instances can sweep at once.
+func (r *Repository) DeleteExpiredSessions(
+	ctx context.Context,
+	lastUsedBefore, createdBefore *time.Time,
+	limit uint64,
+) ([]entity.Session, error) {
+	var expired []entity.Session
+	err := r.sql.sessions.Transaction(ctx, func(ctx context.Context) error {
+		rows, err := r.sql.sessions.New().
+			FilterExpired(lastUsedBefore, createdBefore).
+			ForUpdateSkipLocked().
+			Page(limit, 0).
+			Select(ctx)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, 0, len(rows))
+		for _, row := range rows {
+			ids = append(ids, row.ID)
+			expired = append(expired, row.ToEntity())
+		}
+
+		return r.sql.sessions.New().FilterIDs(ids).Delete(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return expired, nil
+}
+
 func toSessionModel(s pgdb.Session) entity.Session {
 	return entity.Session{
 		ID:        s.ID,
@@ -0,0 +1,57 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+// GetSigningKeys returns the keys that have not expired yet, newest first.
+func (r *Repository) GetSigningKeys(ctx context.Context) ([]entity.SigningKey, error) {
+	rows, err := r.sql.signingKeys.New().
+		FilterValidAt(time.Now().UTC()).
+		OrderCreatedAt(false).
+		Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]entity.SigningKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, row.ToEntity())
+	}
+
+	return keys, nil
+}
+
+// RotateSigningKeys makes key the only active signing key. The previously active keys
+// become retiring and expire at retireAt, keys that have already expired are removed.
+func (r *Repository) RotateSigningKeys(ctx context.Context, key entity.SigningKey, retireAt time.Time) error {
+	now := time.Now().UTC()
+
+	return r.sql.signingKeys.Transaction(ctx, func(ctx context.Context) error {
+		err := r.sql.signingKeys.New().FilterExpiredBefore(now).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = r.sql.signingKeys.New().
+			FilterStatus(entity.SigningKeyStatusActive).
+			UpdateStatus(entity.SigningKeyStatusRetiring).
+			UpdateExpiresAt(&retireAt).
+			Update(ctx)
+		if err != nil {
+			return err
+		}
+
+		return r.sql.signingKeys.Insert(ctx, pgdb.SigningKey{
+			ID:         key.ID,
+			Algorithm:  key.Algorithm,
+			PrivateKey: key.PrivateKey,
+			Status:     entity.SigningKeyStatusActive,
+			CreatedAt:  now,
+		})
+	})
+}
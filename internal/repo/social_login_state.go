@@ -0,0 +1,65 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/repo/pgdb"
+)
+
+// CreateSocialLoginState stores the state and drops the expired ones on the way.
+func (r *Repository) CreateSocialLoginState(
+	ctx context.Context,
+	state entity.SocialLoginState,
+) (entity.SocialLoginState, error) {
+	row := pgdb.SocialLoginState{
+		StateHash:    state.StateHash,
+		Provider:     state.Provider,
+		Purpose:      state.Purpose,
+		AccountID:    uuid.NullUUID{UUID: state.AccountID, Valid: state.AccountID != uuid.Nil},
+		SessionID:    uuid.NullUUID{UUID: state.SessionID, Valid: state.SessionID != uuid.Nil},
+		CodeVerifier: state.CodeVerifier,
+		Nonce:        state.Nonce,
+		RedirectURI:  state.RedirectURI,
+		ExpiresAt:    state.ExpiresAt,
+		CreatedAt:    time.Now().UTC(),
+	}
+
+	err := r.sql.socialStates.Transaction(ctx, func(ctx context.Context) error {
+		err := r.sql.socialStates.New().FilterExpiredBefore(row.CreatedAt).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		return r.sql.socialStates.Insert(ctx, row)
+	})
+	if err != nil {
+		return entity.SocialLoginState{}, err
+	}
+
+	return row.ToEntity(), nil
+}
+
+// ConsumeSocialLoginState returns the state and deletes it, so every state can be used
+// for one callback only.
+func (r *Repository) ConsumeSocialLoginState(ctx context.Context, stateHash string) (entity.SocialLoginState, error) {
+	var state entity.SocialLoginState
+
+	err := r.sql.socialStates.Transaction(ctx, func(ctx context.Context) error {
+		row, err := r.sql.socialStates.New().FilterStateHash(stateHash).ForUpdate().Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		state = row.ToEntity()
+
+		return r.sql.socialStates.New().FilterStateHash(stateHash).Delete(ctx)
+	})
+	if err != nil {
+		return entity.SocialLoginState{}, err
+	}
+
+	return state, nil
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+	"github.com/umisto/sso-svc/internal/rest/requests"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+)
+
+// ApproveOAuthAuthorization is called by the login page for the logged in user. It
+// answers with the client redirect uri carrying the authorization code.
+func (s *Service) ApproveOAuthAuthorization(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	req, err := requests.OAuthAuthorize(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode oauth authorize request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	attrs := req.Data.Attributes
+
+	params := auth.AuthorizeParams{
+		ClientID:            attrs.ClientId,
+		RedirectURI:         attrs.RedirectUri,
+		Scopes:              strings.Fields(attrs.Scope),
+		CodeChallenge:       attrs.CodeChallenge,
+		CodeChallengeMethod: attrs.CodeChallengeMethod,
+	}
+	if attrs.Nonce != nil {
+		params.Nonce = *attrs.Nonce
+	}
+	if attrs.GrantConsent != nil {
+		params.GrantConsent = *attrs.GrantConsent
+	}
+
+	code, err := s.domain.Authorize(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, params)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to authorize oauth client %s", params.ClientID)
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is blocked"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorOAuthClientNotFound):
+			ape.RenderErr(w, problems.NotFound("oauth client not found"))
+		case errors.Is(err, errx.ErrorOAuthRedirectURIInvalid):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/redirect_uri": err,
+			})...)
+		case errors.Is(err, errx.ErrorOAuthScopeInvalid):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/scope": err,
+			})...)
+		case errors.Is(err, errx.ErrorOAuthRequestInvalid):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/code_challenge": err,
+			})...)
+		case errors.Is(err, errx.ErrorOAuthConsentRequired):
+			ape.RenderErr(w, problems.Forbidden("consent_required"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	values := url.Values{"code": {code}}
+	if attrs.State != nil {
+		values.Set("state", *attrs.State)
+	}
+
+	ape.Render(w, http.StatusOK, responses.OAuthAuthorization(withQuery(params.RedirectURI, values)))
+}
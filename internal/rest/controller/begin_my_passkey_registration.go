@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+)
+
+func (s *Service) BeginMyPasskeyRegistration(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	ceremony, err := s.domain.BeginPasskeyRegistration(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	})
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to begin passkey registration")
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is blocked"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.PasskeyCeremony(ceremony))
+}
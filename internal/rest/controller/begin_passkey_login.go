@@ -0,0 +1,21 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+)
+
+func (s *Service) BeginPasskeyLogin(w http.ResponseWriter, r *http.Request) {
+	ceremony, err := s.domain.BeginPasskeyLogin(r.Context())
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to begin passkey login")
+		ape.RenderErr(w, problems.InternalError())
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.PasskeyCeremony(ceremony))
+}
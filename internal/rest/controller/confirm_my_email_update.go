@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+	"github.com/umisto/sso-svc/internal/rest/requests"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+)
+
+func (s *Service) ConfirmMyEmailUpdate(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	req, err := requests.ConfirmEmailUpdate(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode confirm email update request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	email, err := s.domain.ConfirmEmailChange(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, req.Data.Attributes.Code)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to confirm email change")
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is blocked"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorEmailChangeNotFound):
+			ape.RenderErr(w, problems.NotFound("no pending email change"))
+		case errors.Is(err, errx.ErrorEmailChangeExpired):
+			ape.RenderErr(w, problems.Forbidden("email change has expired"))
+		case errors.Is(err, errx.ErrorEmailVerificationCodeInvalid):
+			ape.RenderErr(w, problems.Forbidden("invalid email confirmation code"))
+		case errors.Is(err, errx.ErrorEmailAlreadyExist):
+			ape.RenderErr(w, problems.Conflict("user with this email already exists"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.AccountEmailData(email))
+}
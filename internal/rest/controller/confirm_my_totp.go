@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+	"github.com/umisto/sso-svc/internal/rest/requests"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+)
+
+func (s *Service) ConfirmMyTOTP(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	req, err := requests.ConfirmTOTP(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode confirm totp request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	codes, err := s.domain.ConfirmTOTP(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, req.Data.Attributes.Code)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to confirm totp")
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is blocked"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorMFANotSetUp):
+			ape.RenderErr(w, problems.NotFound("totp setup not started"))
+		case errors.Is(err, errx.ErrorMFAAlreadyEnabled):
+			ape.RenderErr(w, problems.Conflict("two-factor authentication is already enabled"))
+		case errors.Is(err, errx.ErrorMFACodeInvalid):
+			ape.RenderErr(w, problems.Forbidden("invalid totp code"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.RecoveryCodes(initiator.ID, codes))
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+func (s *Service) DeleteAccountSessionAdmin(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	accountID, err := uuid.Parse(chi.URLParam(r, "account_id"))
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid account id: %s", chi.URLParam(r, "account_id"))
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("invalid account id: %s", chi.URLParam(r, "account_id")),
+		})...)
+
+		return
+	}
+
+	sessionID, err := uuid.Parse(chi.URLParam(r, "session_id"))
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid session id: %s", chi.URLParam(r, "session_id"))
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("invalid session id: %s", chi.URLParam(r, "session_id")),
+		})...)
+
+		return
+	}
+
+	if err = s.domain.DeleteAccountSessionByAdmin(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, accountID, sessionID); err != nil {
+		s.log.WithError(err).Errorf("failed to delete session %s of account %s by admin", sessionID, accountID)
+		renderAdminSessionErr(w, err)
+
+		return
+	}
+
+	s.log.Infof("session %s of account %s revoked by admin %s", sessionID, accountID, initiator.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+func (s *Service) DeleteAccountSessionsAdmin(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	accountID, err := uuid.Parse(chi.URLParam(r, "account_id"))
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid account id: %s", chi.URLParam(r, "account_id"))
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("invalid account id: %s", chi.URLParam(r, "account_id")),
+		})...)
+
+		return
+	}
+
+	if err = s.domain.DeleteAccountSessionsByAdmin(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, accountID); err != nil {
+		s.log.WithError(err).Errorf("failed to delete sessions of account %s by admin", accountID)
+		renderAdminSessionErr(w, err)
+
+		return
+	}
+
+	s.log.Infof("sessions of account %s revoked by admin %s", accountID, initiator.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// renderAdminSessionErr renders the errors shared by the admin endpoints revoking the
+// sessions of one account.
+func renderAdminSessionErr(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errx.ErrorInitiatorNotFound):
+		ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+	case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+		ape.RenderErr(w, problems.Forbidden("initiator is not active"))
+	case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+		ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+	case errors.Is(err, errx.ErrorNotEnoughRights):
+		ape.RenderErr(w, problems.Forbidden("only admins can manage sessions of other accounts"))
+	case errors.Is(err, errx.ErrorCannotManageOwnAccount):
+		ape.RenderErr(w, problems.Forbidden("admins manage their own sessions through /me/sessions"))
+	case errors.Is(err, errx.ErrorAccountNotFound):
+		ape.RenderErr(w, problems.NotFound("account not found"))
+	case errors.Is(err, errx.ErrorSessionNotFound):
+		ape.RenderErr(w, problems.NotFound("session not found"))
+	default:
+		ape.RenderErr(w, problems.InternalError())
+	}
+}
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+func (s *Service) DeleteMyPasskey(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	passkeyID, err := uuid.Parse(chi.URLParam(r, "passkey_id"))
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid passkey id: %s", chi.URLParam(r, "passkey_id"))
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("invalid passkey id: %s", chi.URLParam(r, "passkey_id")),
+		})...)
+
+		return
+	}
+
+	if err = s.domain.DeleteOwnPasskey(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, passkeyID); err != nil {
+		s.log.WithError(err).Errorf("failed to delete my passkey")
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is not active"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorPasskeyNotFound):
+			ape.RenderErr(w, problems.NotFound("passkey not found"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+	"github.com/umisto/sso-svc/internal/rest/requests"
+)
+
+func (s *Service) DisableMyTOTP(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	req, err := requests.DisableTOTP(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode disable totp request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	err = s.domain.DisableTOTP(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, req.Data.Attributes.Password, req.Data.Attributes.Code)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to disable totp")
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is blocked"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorPasswordInvalid):
+			ape.RenderErr(w, problems.Unauthorized("invalid password"))
+		case errors.Is(err, errx.ErrorMFANotEnabled):
+			ape.RenderErr(w, problems.Conflict("two-factor authentication is not enabled"))
+		case errors.Is(err, errx.ErrorMFACodeInvalid):
+			ape.RenderErr(w, problems.Forbidden("invalid totp code"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+	"github.com/umisto/sso-svc/internal/rest/requests"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+)
+
+func (s *Service) FinishMyPasskeyRegistration(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	req, err := requests.FinishPasskeyRegistration(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode finish passkey registration request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	credential, err := json.Marshal(req.Data.Attributes.Credential)
+	if err != nil {
+		s.log.WithError(err).Error("failed to encode passkey credential")
+		ape.RenderErr(w, problems.InternalError())
+
+		return
+	}
+
+	passkey, err := s.domain.FinishPasskeyRegistration(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, req.Data.Id, req.Data.Attributes.Name, credential)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to finish passkey registration")
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is blocked"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorPasskeyCeremonyNotFound):
+			ape.RenderErr(w, problems.NotFound("passkey registration not found or expired"))
+		case errors.Is(err, errx.ErrorPasskeyInvalid):
+			ape.RenderErr(w, problems.Forbidden("passkey registration could not be verified"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusCreated, responses.Passkey(passkey))
+}
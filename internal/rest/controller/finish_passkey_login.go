@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/rest/requests"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+)
+
+func (s *Service) FinishPasskeyLogin(w http.ResponseWriter, r *http.Request) {
+	req, err := requests.FinishPasskeyLogin(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode finish passkey login request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	credential, err := json.Marshal(req.Data.Attributes.Credential)
+	if err != nil {
+		s.log.WithError(err).Error("failed to encode passkey credential")
+		ape.RenderErr(w, problems.InternalError())
+
+		return
+	}
+
+	token, err := s.domain.FinishPasskeyLogin(r.Context(), req.Data.Id, credential)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to login user with passkey")
+		switch {
+		case errors.Is(err, errx.ErrorPasskeyCeremonyNotFound):
+			ape.RenderErr(w, problems.Unauthorized("passkey login not found or expired"))
+		case errors.Is(err, errx.ErrorPasskeyInvalid) || errors.Is(err, errx.ErrorAccountNotFound):
+			ape.RenderErr(w, problems.Unauthorized("passkey could not be verified"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("account is not active"))
+		case errors.Is(err, errx.ErrorSessionsLimitReached):
+			ape.RenderErr(w, problems.Forbidden("maximum number of sessions reached, log out of another session"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	s.log.Infof("session %s opened with passkey", token.SessionID)
+
+	ape.Render(w, http.StatusOK, responses.TokensPair(token))
+}
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/rest/requests"
+)
+
+func (s *Service) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	req, err := requests.ForgotPassword(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode forgot password request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	err = s.domain.RequestPasswordReset(r.Context(), req.Data.Attributes.Email)
+	if err != nil {
+		// Unknown and inactive accounts get the same response as existing ones,
+		// so this endpoint can't be used to enumerate registered emails.
+		switch {
+		case errors.Is(err, errx.ErrorAccountNotFound) || errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			s.log.WithError(err).Debugf("password reset requested for unavailable account")
+		default:
+			s.log.WithError(err).Errorf("failed to request password reset")
+			ape.RenderErr(w, problems.InternalError())
+
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
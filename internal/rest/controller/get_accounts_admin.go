@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/restkit/pagi"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (s *Service) GetAccountsAdmin(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	filter, err := accountsFilter(r)
+	if err != nil {
+		s.log.WithError(err).Error("invalid accounts filter")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": err,
+		})...)
+
+		return
+	}
+
+	page, size := pagi.GetPagination(r)
+	accounts, err := s.domain.GetAccountsByAdmin(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, filter, page, size)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to select accounts by admin")
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is not active"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorNotEnoughRights):
+			ape.RenderErr(w, problems.Forbidden("only admins can manage accounts"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.AccountsCollection(accounts))
+}
+
+// accountsFilter reads the filter of the accounts list from the query, email and username
+// match by prefix, created_after and created_before are RFC 3339 timestamps.
+func accountsFilter(r *http.Request) (auth.AccountsFilter, error) {
+	q := r.URL.Query()
+
+	filter := auth.AccountsFilter{
+		Status:         q.Get("status"),
+		Role:           q.Get("role"),
+		EmailPrefix:    q.Get("email"),
+		UsernamePrefix: q.Get("username"),
+	}
+
+	for param, dst := range map[string]**time.Time{
+		"created_after":  &filter.CreatedAfter,
+		"created_before": &filter.CreatedBefore,
+	} {
+		v := q.Get(param)
+		if v == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return auth.AccountsFilter{}, fmt.Errorf("invalid %s: %s", param, v)
+		}
+
+		*dst = &t
+	}
+
+	return filter, nil
+}
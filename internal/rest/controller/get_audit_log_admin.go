@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (s *Service) GetAuditLogAdmin(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	filter, err := auditLogFilter(r)
+	if err != nil {
+		s.log.WithError(err).Error("invalid audit log filter")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": err,
+		})...)
+
+		return
+	}
+
+	cursor, limit, err := cursorPagination(r)
+	if err != nil {
+		s.log.WithError(err).Error("invalid audit log pagination")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": err,
+		})...)
+
+		return
+	}
+
+	entries, err := s.domain.GetAuditLogByAdmin(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, filter, cursor, limit)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to select audit log by admin")
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is not active"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorNotEnoughRights):
+			ape.RenderErr(w, problems.Forbidden("only admins can read the audit log"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.AuditLogEntriesCollection(entries))
+}
+
+// auditLogFilter reads the filter of the audit log from the query, created_after and
+// created_before are RFC 3339 timestamps.
+func auditLogFilter(r *http.Request) (auth.AuditLogFilter, error) {
+	q := r.URL.Query()
+
+	filter := auth.AuditLogFilter{
+		Action: q.Get("action"),
+	}
+
+	for param, dst := range map[string]*uuid.UUID{
+		"actor_id":  &filter.ActorID,
+		"target_id": &filter.TargetID,
+	} {
+		v := q.Get(param)
+		if v == "" {
+			continue
+		}
+
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return auth.AuditLogFilter{}, fmt.Errorf("invalid %s: %s", param, v)
+		}
+
+		*dst = id
+	}
+
+	for param, dst := range map[string]**time.Time{
+		"created_after":  &filter.CreatedAfter,
+		"created_before": &filter.CreatedBefore,
+	} {
+		v := q.Get(param)
+		if v == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return auth.AuditLogFilter{}, fmt.Errorf("invalid %s: %s", param, v)
+		}
+
+		*dst = &t
+	}
+
+	return filter, nil
+}
+
+// cursorPagination reads the cursor and limit of a cursor paginated list, a missing
+// cursor starts at the first page and a missing limit leaves the default.
+func cursorPagination(r *http.Request) (uuid.UUID, uint64, error) {
+	q := r.URL.Query()
+
+	var cursor uuid.UUID
+	if v := q.Get("cursor"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return uuid.Nil, 0, fmt.Errorf("invalid cursor: %s", v)
+		}
+
+		cursor = id
+	}
+
+	var limit uint64
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return uuid.Nil, 0, fmt.Errorf("invalid limit: %s", v)
+		}
+
+		limit = n
+	}
+
+	return cursor, limit, nil
+}
@@ -0,0 +1,17 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// GetJWKS publishes the public signing keys. Retiring keys stay in the set until the
+// tokens they signed have expired, clients may cache it for a short while.
+func (s *Service) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+
+	if err := json.NewEncoder(w).Encode(s.keys.JWKS()); err != nil {
+		s.log.WithError(err).Error("failed to render jwks")
+	}
+}
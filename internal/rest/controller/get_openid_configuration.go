@@ -0,0 +1,24 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/umisto/sso-svc/internal/rest/responses"
+)
+
+func (s *Service) GetOpenIDConfiguration(w http.ResponseWriter, r *http.Request) {
+	keys := s.keys.JWKS().Keys
+
+	algorithms := make([]string, 0, len(keys))
+	for _, key := range keys {
+		algorithms = append(algorithms, key.Alg)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+
+	if err := json.NewEncoder(w).Encode(responses.NewOpenIDConfiguration(s.oidc.Issuer, algorithms)); err != nil {
+		s.log.WithError(err).Error("failed to render openid configuration")
+	}
+}
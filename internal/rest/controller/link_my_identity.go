@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+	"github.com/umisto/sso-svc/internal/rest/requests"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// LinkMyIdentity starts linking a provider user to the account. The user agent is to be
+// sent to the returned authorization url, the login callback then links the identity. The
+// state is bound to the user agent by a cookie, so the request must be sent with credentials.
+func (s *Service) LinkMyIdentity(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	req, err := requests.LinkAccountIdentity(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid link identity request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	provider, ok := s.social.Provider(req.Data.Attributes.Provider)
+	if !ok {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"data/attributes/provider": fmt.Errorf("unknown login provider %s", req.Data.Attributes.Provider),
+		})...)
+
+		return
+	}
+
+	var redirectURI string
+	if req.Data.Attributes.RedirectUri != nil {
+		redirectURI = *req.Data.Attributes.RedirectUri
+	}
+
+	state, err := s.domain.BeginOwnIdentityLink(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, provider.Name(), redirectURI)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to begin linking my %s identity", provider.Name())
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is not active"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorSocialRedirectURINotAllowed):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/redirect_uri": fmt.Errorf("redirect uri is not allowed"),
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	setSocialStateCookie(w, provider, state)
+	ape.Render(w, http.StatusOK, responses.SocialAuthorization(provider.AuthCodeURL(socialAuthParams(state)), state.ExpiresAt))
+}
@@ -20,7 +20,12 @@ func (s *Service) LoginByEmail(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := s.domain.LoginByEmail(r.Context(), req.Data.Attributes.Email, req.Data.Attributes.Password)
+	token, challenge, err := s.domain.LoginByEmail(
+		r.Context(),
+		req.Data.Attributes.Email,
+		req.Data.Attributes.Password,
+		clientIP(r),
+	)
 	if err != nil {
 		s.log.WithError(err).Errorf("failed to login user")
 		switch {
@@ -28,6 +33,10 @@ func (s *Service) LoginByEmail(w http.ResponseWriter, r *http.Request) {
 			ape.RenderErr(w, problems.Unauthorized("invalid login or password"))
 		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
 			ape.RenderErr(w, problems.Forbidden("account is not active"))
+		case errors.Is(err, errx.ErrorAccountTemporarilyLocked):
+			ape.RenderErr(w, tooManyRequests("too many failed login attempts, try again later"))
+		case errors.Is(err, errx.ErrorSessionsLimitReached):
+			ape.RenderErr(w, problems.Forbidden("maximum number of sessions reached, log out of another session"))
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
@@ -35,6 +44,12 @@ func (s *Service) LoginByEmail(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !challenge.IsNil() {
+		ape.Render(w, http.StatusAccepted, responses.MFAChallenge(challenge))
+
+		return
+	}
+
 	s.log.Infof("user %s logged in successfully", req.Data.Attributes.Email)
 
 	ape.Render(w, http.StatusOK, responses.TokensPair(token))
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/rest/requests"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+)
+
+func (s *Service) LoginByMFA(w http.ResponseWriter, r *http.Request) {
+	req, err := requests.LoginMFA(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode login mfa request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	token, err := s.domain.LoginByMFA(
+		r.Context(),
+		req.Data.Attributes.Challenge,
+		req.Data.Attributes.Code,
+		clientIP(r),
+	)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to login user with mfa")
+		switch {
+		case errors.Is(err, errx.ErrorMFAChallengeInvalid) || errors.Is(err, errx.ErrorAccountNotFound):
+			ape.RenderErr(w, problems.Unauthorized("invalid or expired mfa challenge"))
+		case errors.Is(err, errx.ErrorMFACodeInvalid):
+			ape.RenderErr(w, problems.Unauthorized("invalid mfa code"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("account is not active"))
+		case errors.Is(err, errx.ErrorAccountTemporarilyLocked):
+			ape.RenderErr(w, tooManyRequests("too many failed login attempts, try again later"))
+		case errors.Is(err, errx.ErrorSessionsLimitReached):
+			ape.RenderErr(w, problems.Forbidden("maximum number of sessions reached, log out of another session"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	s.log.Infof("session %s opened after mfa challenge", token.SessionID)
+
+	ape.Render(w, http.StatusOK, responses.TokensPair(token))
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/social"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// LoginBySocialProvider sends the user agent to the login page of the provider. The
+// optional redirect_uri is where the callback sends the user agent with the tokens.
+func (s *Service) LoginBySocialProvider(w http.ResponseWriter, r *http.Request) {
+	provider, ok := s.social.Provider(chi.URLParam(r, "provider"))
+	if !ok {
+		ape.RenderErr(w, problems.NotFound("login provider not found"))
+		return
+	}
+
+	state, err := s.domain.BeginSocialLogin(r.Context(), provider.Name(), r.FormValue("redirect_uri"))
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to begin %s login", provider.Name())
+		switch {
+		case errors.Is(err, errx.ErrorSocialRedirectURINotAllowed):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"query": fmt.Errorf("redirect_uri is not allowed"),
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	setSocialStateCookie(w, provider, state)
+	http.Redirect(w, r, provider.AuthCodeURL(socialAuthParams(state)), http.StatusFound)
+}
+
+func socialAuthParams(state entity.SocialLoginState) social.AuthParams {
+	return social.AuthParams{
+		State:        state.State,
+		CodeVerifier: state.CodeVerifier,
+		Nonce:        state.Nonce,
+	}
+}
@@ -0,0 +1,181 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// LoginBySocialProviderCallback finishes the login or identity link the state was issued
+// for. The code and state come in the query or, for providers posting the callback, in
+// the form body. The state must match the cookie set for the user agent that started the
+// flow, which stops login and link csrf.
+func (s *Service) LoginBySocialProviderCallback(w http.ResponseWriter, r *http.Request) {
+	provider, ok := s.social.Provider(chi.URLParam(r, "provider"))
+	if !ok {
+		ape.RenderErr(w, problems.NotFound("login provider not found"))
+		return
+	}
+
+	if !checkSocialStateCookie(r, r.FormValue("state")) {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("state does not belong to this user agent"),
+		})...)
+
+		return
+	}
+
+	clearSocialStateCookie(w)
+
+	state, err := s.domain.ConsumeSocialLoginState(r.Context(), provider.Name(), r.FormValue("state"))
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to get %s login state", provider.Name())
+		switch {
+		case errors.Is(err, errx.ErrorSocialStateInvalid):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"query": fmt.Errorf("state is missing, expired or already used"),
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	if providerErr := r.FormValue("error"); providerErr != "" {
+		s.log.Errorf("login provider %s returned error %s: %s", provider.Name(), providerErr, r.FormValue("error_description"))
+		ape.RenderErr(w, problems.Unauthorized("login provider did not authorize the user"))
+
+		return
+	}
+
+	code := r.FormValue("code")
+	if code == "" {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("code is required"),
+		})...)
+
+		return
+	}
+
+	identity, err := provider.Exchange(r.Context(), code, socialAuthParams(state))
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to get user from login provider %s", provider.Name())
+		ape.RenderErr(w, problems.Unauthorized("login provider rejected the authorization code"))
+
+		return
+	}
+
+	if state.Purpose == entity.SocialLoginPurposeLink {
+		s.linkSocialIdentity(w, r, state, identity)
+		return
+	}
+
+	tokensPair, challenge, err := s.domain.LoginBySocialIdentity(r.Context(), identity)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to login %s user %s", provider.Name(), identity.Subject)
+		switch {
+		case errors.Is(err, errx.ErrorSocialEmailNotVerified):
+			ape.RenderErr(w, problems.Forbidden("email is not verified, log in and link the identity instead"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("account is not active"))
+		case errors.Is(err, errx.ErrorAccountNotFound):
+			ape.RenderErr(w, problems.NotFound("user with this email not found"))
+		case errors.Is(err, errx.ErrorSessionsLimitReached):
+			ape.RenderErr(w, problems.Forbidden("maximum number of sessions reached, log out of another session"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	if !challenge.IsNil() {
+		if state.RedirectURI != "" {
+			http.Redirect(w, r, withFragment(state.RedirectURI, url.Values{
+				"mfa_challenge": {challenge.Token},
+				"expires_at":    {challenge.ExpiresAt.Format(time.RFC3339)},
+			}), http.StatusFound)
+
+			return
+		}
+
+		ape.Render(w, http.StatusAccepted, responses.MFAChallenge(challenge))
+
+		return
+	}
+
+	s.log.Infof("session %s opened with %s", tokensPair.SessionID, provider.Name())
+
+	if state.RedirectURI != "" {
+		http.Redirect(w, r, withFragment(state.RedirectURI, url.Values{
+			"session_id":    {tokensPair.SessionID.String()},
+			"access_token":  {tokensPair.Access},
+			"refresh_token": {tokensPair.Refresh},
+		}), http.StatusFound)
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.TokensPair(tokensPair))
+}
+
+func (s *Service) linkSocialIdentity(
+	w http.ResponseWriter,
+	r *http.Request,
+	state entity.SocialLoginState,
+	identity entity.SocialIdentity,
+) {
+	linked, err := s.domain.LinkOwnIdentity(r.Context(), auth.InitiatorData{
+		AccountID: state.AccountID,
+		SessionID: state.SessionID,
+	}, identity)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to link %s identity to account %s", identity.Provider, state.AccountID)
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is not active"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorAccountIdentityAlreadyLinked):
+			ape.RenderErr(w, problems.Conflict("identity is linked to another account"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	if state.RedirectURI != "" {
+		http.Redirect(w, r, withFragment(state.RedirectURI, url.Values{
+			"identity_id": {linked.ID.String()},
+		}), http.StatusFound)
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.AccountIdentity(linked))
+}
+
+// withFragment puts values into the fragment of the uri, unlike the query it is not sent
+// to the server hosting the page.
+func withFragment(uri string, values url.Values) string {
+	uri, _, _ = strings.Cut(uri, "#")
+
+	return uri + "#" + values.Encode()
+}
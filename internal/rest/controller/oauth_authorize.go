@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+)
+
+// OAuthAuthorize is the authorization endpoint the clients send the user agent to. The
+// request is validated and handed over to the login page, which logs the user in and
+// completes it with ApproveOAuthAuthorization.
+func (s *Service) OAuthAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	params := auth.AuthorizeParams{
+		ClientID:            query.Get("client_id"),
+		RedirectURI:         query.Get("redirect_uri"),
+		Scopes:              strings.Fields(query.Get("scope")),
+		Nonce:               query.Get("nonce"),
+		CodeChallenge:       query.Get("code_challenge"),
+		CodeChallengeMethod: query.Get("code_challenge_method"),
+	}
+
+	client, err := s.domain.ValidateAuthorizeRequest(r.Context(), params)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid authorization request")
+		switch {
+		case errors.Is(err, errx.ErrorOAuthClientNotFound) || errors.Is(err, errx.ErrorOAuthRedirectURIInvalid):
+			// the redirect uri can not be trusted, the error is shown to the user instead
+			renderOAuthError(w, http.StatusBadRequest, "invalid_request", "unknown client or redirect uri")
+		case errors.Is(err, errx.ErrorOAuthScopeInvalid):
+			redirectOAuthError(w, r, params.RedirectURI, query.Get("state"), "invalid_scope")
+		case errors.Is(err, errx.ErrorOAuthRequestInvalid):
+			redirectOAuthError(w, r, params.RedirectURI, query.Get("state"), "invalid_request")
+		default:
+			redirectOAuthError(w, r, params.RedirectURI, query.Get("state"), "server_error")
+		}
+
+		return
+	}
+
+	if query.Get("response_type") != "code" {
+		redirectOAuthError(w, r, params.RedirectURI, query.Get("state"), "unsupported_response_type")
+		return
+	}
+
+	query.Set("client_name", client.Name)
+
+	http.Redirect(w, r, withQuery(s.oidc.LoginURL, query), http.StatusFound)
+}
+
+func redirectOAuthError(w http.ResponseWriter, r *http.Request, redirectURI, state, code string) {
+	values := url.Values{"error": {code}}
+	if state != "" {
+		values.Set("state", state)
+	}
+
+	http.Redirect(w, r, withQuery(redirectURI, values), http.StatusFound)
+}
+
+// withQuery appends values to the query the uri may already have.
+func withQuery(uri string, values url.Values) string {
+	if strings.Contains(uri, "?") {
+		return uri + "&" + values.Encode()
+	}
+
+	return uri + "?" + values.Encode()
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+)
+
+func (s *Service) OAuthIntrospect(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		renderOAuthError(w, http.StatusBadRequest, "invalid_request", "malformed form body")
+		return
+	}
+
+	tokenStr := r.PostForm.Get("token")
+	if tokenStr == "" {
+		renderOAuthError(w, http.StatusBadRequest, "invalid_request", "token is required")
+		return
+	}
+
+	clientID, clientSecret := oauthClientCredentials(r)
+
+	introspection, err := s.domain.IntrospectToken(
+		r.Context(),
+		clientID, clientSecret,
+		tokenStr, r.PostForm.Get("token_type_hint"),
+	)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to introspect token for oauth client %s", clientID)
+		switch {
+		case errors.Is(err, errx.ErrorOAuthClientUnauthorized):
+			w.Header().Set("WWW-Authenticate", `Basic realm="sso-svc"`)
+			renderOAuthError(w, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+		default:
+			renderOAuthError(w, http.StatusInternalServerError, "server_error", "")
+		}
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err = json.NewEncoder(w).Encode(responses.NewTokenIntrospection(introspection)); err != nil {
+		s.log.WithError(err).Error("failed to render token introspection")
+	}
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/umisto/sso-svc/internal/domain/errx"
+)
+
+// OAuthRevoke answers 200 for unknown and already revoked tokens as well, as required by
+// RFC 7009, so the response tells nothing about the token. Tokens issued to another
+// client are refused.
+func (s *Service) OAuthRevoke(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		renderOAuthError(w, http.StatusBadRequest, "invalid_request", "malformed form body")
+		return
+	}
+
+	tokenStr := r.PostForm.Get("token")
+	if tokenStr == "" {
+		renderOAuthError(w, http.StatusBadRequest, "invalid_request", "token is required")
+		return
+	}
+
+	clientID, clientSecret := oauthClientCredentials(r)
+
+	err := s.domain.RevokeToken(r.Context(), clientID, clientSecret, tokenStr, r.PostForm.Get("token_type_hint"))
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to revoke token for oauth client %s", clientID)
+		switch {
+		case errors.Is(err, errx.ErrorOAuthClientUnauthorized):
+			w.Header().Set("WWW-Authenticate", `Basic realm="sso-svc"`)
+			renderOAuthError(w, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+		case errors.Is(err, errx.ErrorOAuthGrantInvalid):
+			renderOAuthError(w, http.StatusBadRequest, "invalid_grant", "token was not issued to the client")
+		default:
+			renderOAuthError(w, http.StatusInternalServerError, "server_error", "")
+		}
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+)
+
+// OAuthToken is the token endpoint. The client authenticates with http basic auth or
+// with the client_id and client_secret form fields, public clients send client_id only.
+func (s *Service) OAuthToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		renderOAuthError(w, http.StatusBadRequest, "invalid_request", "malformed form body")
+		return
+	}
+
+	clientID, clientSecret := oauthClientCredentials(r)
+
+	var (
+		tokens entity.OAuthTokens
+		err    error
+	)
+
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		tokens, err = s.domain.ExchangeAuthorizationCode(r.Context(), auth.ExchangeAuthorizationCodeParams{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Code:         r.PostForm.Get("code"),
+			RedirectURI:  r.PostForm.Get("redirect_uri"),
+			CodeVerifier: r.PostForm.Get("code_verifier"),
+		})
+	case "refresh_token":
+		tokens, err = s.domain.RefreshOAuthTokens(r.Context(), clientID, clientSecret, r.PostForm.Get("refresh_token"))
+	default:
+		renderOAuthError(w, http.StatusBadRequest, "unsupported_grant_type", "")
+		return
+	}
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to issue tokens for oauth client %s", clientID)
+		switch {
+		case errors.Is(err, errx.ErrorOAuthClientUnauthorized):
+			w.Header().Set("WWW-Authenticate", `Basic realm="sso-svc"`)
+			renderOAuthError(w, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+		case errors.Is(err, errx.ErrorOAuthGrantInvalid),
+			errors.Is(err, errx.ErrorSessionNotFound),
+			errors.Is(err, errx.ErrorSessionExpired),
+			errors.Is(err, errx.ErrorSessionTokenMismatch),
+			errors.Is(err, errx.ErrorAccountNotFound),
+			errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			renderOAuthError(w, http.StatusBadRequest, "invalid_grant", "grant is invalid, expired or revoked")
+		case errors.Is(err, errx.ErrorSessionsLimitReached):
+			renderOAuthError(w, http.StatusBadRequest, "invalid_grant", "maximum number of sessions reached")
+		default:
+			renderOAuthError(w, http.StatusInternalServerError, "server_error", "")
+		}
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err = json.NewEncoder(w).Encode(responses.NewOAuthTokens(tokens, s.oidc.AccessTTL)); err != nil {
+		s.log.WithError(err).Error("failed to render oauth tokens")
+	}
+}
+
+// oauthClientCredentials reads the client credentials from http basic auth or from the
+// parsed form.
+func oauthClientCredentials(r *http.Request) (string, string) {
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		return clientID, clientSecret
+	}
+
+	return r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+}
+
+func renderOAuthError(w http.ResponseWriter, status int, code, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(responses.OAuthError{
+		Error:            code,
+		ErrorDescription: description,
+	})
+}
@@ -30,8 +30,10 @@ func (s *Service) RefreshSession(w http.ResponseWriter, r *http.Request) {
 			ape.RenderErr(w, problems.Forbidden("account is not active"))
 		case errors.Is(err, errx.ErrorSessionNotFound):
 			ape.RenderErr(w, problems.Unauthorized("session not found"))
+		case errors.Is(err, errx.ErrorSessionExpired):
+			ape.RenderErr(w, problems.Unauthorized("session expired"))
 		case errors.Is(err, errx.ErrorSessionTokenMismatch):
-			ape.RenderErr(w, problems.Forbidden("refresh session token mismatch"))
+			ape.RenderErr(w, problems.Forbidden("refresh token has already been used, session revoked"))
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
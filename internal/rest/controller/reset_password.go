@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/rest/requests"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (s *Service) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	req, err := requests.ResetPassword(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode reset password request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	err = s.domain.ResetPassword(r.Context(), req.Data.Attributes.Token, req.Data.Attributes.NewPassword)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to reset password")
+		switch {
+		case errors.Is(err, errx.ErrorPasswordResetTokenInvalid) || errors.Is(err, errx.ErrorAccountNotFound):
+			ape.RenderErr(w, problems.Unauthorized("invalid password reset token"))
+		case errors.Is(err, errx.ErrorPasswordResetTokenExpired):
+			ape.RenderErr(w, problems.Unauthorized("password reset token expired"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("account is not active"))
+		case errors.Is(err, errx.ErrorPasswordIsNotAllowed):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/new_password": err,
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
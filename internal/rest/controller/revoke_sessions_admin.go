@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+	"github.com/umisto/sso-svc/internal/rest/requests"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (s *Service) RevokeSessionsAdmin(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	req, err := requests.RevokeSessions(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode revoke sessions request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	revoked, err := s.domain.RevokeSessionsByAdmin(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, auth.SessionsFilter{
+		Role:          req.Data.Attributes.GetRole(),
+		CreatedBefore: req.Data.Attributes.CreatedBefore,
+	}, req.Data.Attributes.Reason)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to revoke sessions by admin")
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is not active"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorNotEnoughRights):
+			ape.RenderErr(w, problems.Forbidden("only admins can revoke sessions"))
+		case errors.Is(err, errx.ErrorSessionsFilterRequired):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes": fmt.Errorf("role or created_before is required"),
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	s.log.Infof("%d sessions revoked by admin %s: %s", revoked, initiator.ID, req.Data.Attributes.Reason)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -2,12 +2,17 @@ package controller
 
 import (
 	"context"
+	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/google/jsonapi"
 	"github.com/google/uuid"
 	"github.com/umisto/logium"
 	"github.com/umisto/sso-svc/internal/domain/entity"
 	"github.com/umisto/sso-svc/internal/domain/modules/auth"
-	"golang.org/x/oauth2"
+	"github.com/umisto/sso-svc/internal/social"
+	"github.com/umisto/sso-svc/internal/token"
 )
 
 type core interface {
@@ -21,9 +26,58 @@ type core interface {
 		params auth.RegistrationParams,
 	) (entity.Account, error)
 
-	LoginByEmail(ctx context.Context, email, password string) (entity.TokensPair, error)
-	LoginByUsername(ctx context.Context, username, password string) (entity.TokensPair, error)
-	LoginByGoogle(ctx context.Context, email string) (entity.TokensPair, error)
+	GetAccountsByAdmin(
+		ctx context.Context,
+		initiator auth.InitiatorData,
+		filter auth.AccountsFilter,
+		page, size int32,
+	) (entity.AccountsCollection, error)
+	GetAccountByAdmin(ctx context.Context, initiator auth.InitiatorData, accountID uuid.UUID) (entity.Account, error)
+	UpdateAccountByAdmin(
+		ctx context.Context,
+		initiator auth.InitiatorData,
+		accountID uuid.UUID,
+		params auth.UpdateAccountByAdminParams,
+	) (entity.Account, error)
+
+	GetAccountSessionsByAdmin(
+		ctx context.Context,
+		initiator auth.InitiatorData,
+		accountID uuid.UUID,
+		page, size int32,
+	) (entity.SessionsCollection, error)
+	DeleteAccountSessionByAdmin(ctx context.Context, initiator auth.InitiatorData, accountID, sessionID uuid.UUID) error
+	DeleteAccountSessionsByAdmin(ctx context.Context, initiator auth.InitiatorData, accountID uuid.UUID) error
+	RevokeSessionsByAdmin(
+		ctx context.Context,
+		initiator auth.InitiatorData,
+		filter auth.SessionsFilter,
+		reason string,
+	) (int64, error)
+
+	GetAuditLogByAdmin(
+		ctx context.Context,
+		initiator auth.InitiatorData,
+		filter auth.AuditLogFilter,
+		cursor uuid.UUID,
+		limit uint64,
+	) (entity.AuditLogPage, error)
+	GetOwnSecurityActivity(
+		ctx context.Context,
+		initiator auth.InitiatorData,
+		cursor uuid.UUID,
+		limit uint64,
+	) (entity.AuditLogPage, error)
+
+	LoginByEmail(ctx context.Context, email, password, ip string) (entity.TokensPair, entity.MFAChallenge, error)
+	LoginByUsername(ctx context.Context, username, password, ip string) (entity.TokensPair, entity.MFAChallenge, error)
+	LoginByMFA(ctx context.Context, challenge, code, ip string) (entity.TokensPair, error)
+	LoginBySocialIdentity(
+		ctx context.Context,
+		identity entity.SocialIdentity,
+	) (entity.TokensPair, entity.MFAChallenge, error)
+	BeginSocialLogin(ctx context.Context, provider, redirectURI string) (entity.SocialLoginState, error)
+	ConsumeSocialLoginState(ctx context.Context, provider, state string) (entity.SocialLoginState, error)
 
 	Refresh(ctx context.Context, oldRefreshToken string) (entity.TokensPair, error)
 
@@ -39,9 +93,47 @@ type core interface {
 		newUsername string,
 	) (entity.Account, error)
 
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+
 	GetAccountByID(ctx context.Context, ID uuid.UUID) (entity.Account, error)
 	GetAccountEmail(ctx context.Context, ID uuid.UUID) (entity.AccountEmail, error)
 
+	VerifyEmail(ctx context.Context, initiator auth.InitiatorData, code string) (entity.AccountEmail, error)
+	ResendEmailVerification(ctx context.Context, initiator auth.InitiatorData) error
+	RequestEmailChange(ctx context.Context, initiator auth.InitiatorData, password, newEmail string) error
+	ConfirmEmailChange(ctx context.Context, initiator auth.InitiatorData, code string) (entity.AccountEmail, error)
+
+	SetupTOTP(ctx context.Context, initiator auth.InitiatorData, password string) (entity.TOTPSetup, error)
+	ConfirmTOTP(ctx context.Context, initiator auth.InitiatorData, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, initiator auth.InitiatorData, password, code string) error
+
+	BeginPasskeyRegistration(ctx context.Context, initiator auth.InitiatorData) (entity.PasskeyCeremony, error)
+	FinishPasskeyRegistration(
+		ctx context.Context,
+		initiator auth.InitiatorData,
+		ceremonyID uuid.UUID,
+		name string,
+		response []byte,
+	) (entity.Passkey, error)
+	GetOwnPasskeys(ctx context.Context, initiator auth.InitiatorData) ([]entity.Passkey, error)
+	DeleteOwnPasskey(ctx context.Context, initiator auth.InitiatorData, passkeyID uuid.UUID) error
+	BeginPasskeyLogin(ctx context.Context) (entity.PasskeyCeremony, error)
+	FinishPasskeyLogin(ctx context.Context, ceremonyID uuid.UUID, response []byte) (entity.TokensPair, error)
+
+	BeginOwnIdentityLink(
+		ctx context.Context,
+		initiator auth.InitiatorData,
+		provider, redirectURI string,
+	) (entity.SocialLoginState, error)
+	LinkOwnIdentity(
+		ctx context.Context,
+		initiator auth.InitiatorData,
+		identity entity.SocialIdentity,
+	) (entity.AccountIdentity, error)
+	GetOwnIdentities(ctx context.Context, initiator auth.InitiatorData) ([]entity.AccountIdentity, error)
+	UnlinkOwnIdentity(ctx context.Context, initiator auth.InitiatorData, identityID uuid.UUID) error
+
 	GetOwnSession(ctx context.Context, initiator auth.InitiatorData, sessionID uuid.UUID) (entity.Session, error)
 	GetOwnSessions(
 		ctx context.Context,
@@ -55,18 +147,72 @@ type core interface {
 	Logout(ctx context.Context, initiator auth.InitiatorData) error
 	DeleteOwnSession(ctx context.Context, initiator auth.InitiatorData, sessionID uuid.UUID) error
 	DeleteOwnSessions(ctx context.Context, initiator auth.InitiatorData) error
+
+	ValidateAuthorizeRequest(ctx context.Context, params auth.AuthorizeParams) (entity.OAuthClient, error)
+	Authorize(ctx context.Context, initiator auth.InitiatorData, params auth.AuthorizeParams) (string, error)
+	ExchangeAuthorizationCode(
+		ctx context.Context,
+		params auth.ExchangeAuthorizationCodeParams,
+	) (entity.OAuthTokens, error)
+	RefreshOAuthTokens(
+		ctx context.Context,
+		clientID, clientSecret string,
+		refreshToken string,
+	) (entity.OAuthTokens, error)
+	GetUserInfo(ctx context.Context, initiator auth.InitiatorData, grant auth.OAuthGrant) (entity.UserInfo, error)
+	IntrospectToken(
+		ctx context.Context,
+		clientID, clientSecret string,
+		tokenStr, tokenTypeHint string,
+	) (entity.TokenIntrospection, error)
+	RevokeToken(ctx context.Context, clientID, clientSecret string, tokenStr, tokenTypeHint string) error
+}
+
+type keys interface {
+	JWKS() token.JWKS
+}
+
+type socialProviders interface {
+	Provider(name string) (social.Provider, bool)
+}
+
+// OIDCConfig describes the openid connect provider to the clients.
+type OIDCConfig struct {
+	Issuer string
+	// LoginURL is the page the authorization endpoint sends the user agent to, it gets
+	// the authorization request as query parameters.
+	LoginURL  string
+	AccessTTL time.Duration
 }
 
 type Service struct {
-	google oauth2.Config
+	social socialProviders
+	oidc   OIDCConfig
 	domain core
+	keys   keys
 	log    logium.Logger
 }
 
-func New(log logium.Logger, google oauth2.Config, domain core) *Service {
+func New(log logium.Logger, social socialProviders, oidc OIDCConfig, domain core, keys keys) *Service {
 	return &Service{
 		log:    log,
-		google: google,
+		social: social,
+		oidc:   oidc,
 		domain: domain,
+		keys:   keys,
+	}
+}
+
+// clientIP returns the address of the client, the ClientData middleware resolves it
+// from the forwarding headers of the trusted proxies before the handlers run.
+func clientIP(r *http.Request) string {
+	return auth.ClientDataFromCtx(r.Context()).IP
+}
+
+func tooManyRequests(detail string) *jsonapi.ErrorObject {
+	return &jsonapi.ErrorObject{
+		Title:  http.StatusText(http.StatusTooManyRequests),
+		Status: strconv.Itoa(http.StatusTooManyRequests),
+		Detail: detail,
 	}
 }
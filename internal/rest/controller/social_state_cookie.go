@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/social"
+)
+
+const (
+	socialStateCookieName = "sso_social_state"
+	// socialStateCookiePath covers the callbacks of every provider.
+	socialStateCookiePath = "/sso-svc/v1/login"
+)
+
+// setSocialStateCookie binds the state to the user agent starting the login or link. The
+// callback accepts the state only from the same user agent, so an attacker can not have
+// a victim finish a flow the attacker started. Browsers send lax cookies on the redirect
+// to the callback but not on a cross-site post, so the cookie of a provider posting the
+// callback is sent with any request.
+func setSocialStateCookie(w http.ResponseWriter, provider social.Provider, state entity.SocialLoginState) {
+	sameSite := http.SameSiteLaxMode
+	if provider.PostsCallback() {
+		sameSite = http.SameSiteNoneMode
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     socialStateCookieName,
+		Value:    hashSocialState(state.State),
+		Path:     socialStateCookiePath,
+		Expires:  state.ExpiresAt,
+		MaxAge:   int(time.Until(state.ExpiresAt).Seconds()),
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: sameSite,
+	})
+}
+
+// checkSocialStateCookie reports whether the state is the one bound to the user agent.
+func checkSocialStateCookie(r *http.Request, state string) bool {
+	cookie, err := r.Cookie(socialStateCookieName)
+	if err != nil || state == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(hashSocialState(state))) == 1
+}
+
+func clearSocialStateCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     socialStateCookieName,
+		Path:     socialStateCookiePath,
+		MaxAge:   -1,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func hashSocialState(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
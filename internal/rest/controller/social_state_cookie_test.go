@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/umisto/logium"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/internal/social"
+)
+
+type testProvider struct {
+	postsCallback bool
+}
+
+func (p testProvider) Name() string { return "apple" }
+
+func (p testProvider) PostsCallback() bool { return p.postsCallback }
+
+func (p testProvider) AuthCodeURL(params social.AuthParams) string {
+	return "https://provider.example.com/authorize?state=" + params.State
+}
+
+func (p testProvider) Exchange(context.Context, string, social.AuthParams) (entity.SocialIdentity, error) {
+	return entity.SocialIdentity{}, fmt.Errorf("exchange is not expected in the test")
+}
+
+type testProviders map[string]social.Provider
+
+func (p testProviders) Provider(name string) (social.Provider, bool) {
+	provider, ok := p[name]
+	return provider, ok
+}
+
+// testCore fakes the social login state calls, any other call panics on the nil
+// embedded interface.
+type testCore struct {
+	core
+
+	consumed []string
+}
+
+func (c *testCore) BeginSocialLogin(_ context.Context, provider, redirectURI string) (entity.SocialLoginState, error) {
+	return entity.SocialLoginState{
+		State:       "state-value",
+		Provider:    provider,
+		RedirectURI: redirectURI,
+		ExpiresAt:   time.Now().Add(time.Minute),
+	}, nil
+}
+
+func (c *testCore) ConsumeSocialLoginState(_ context.Context, provider, state string) (entity.SocialLoginState, error) {
+	c.consumed = append(c.consumed, state)
+	return entity.SocialLoginState{State: state, Provider: provider}, nil
+}
+
+func TestSocialStateCookieOnPostedCallback(t *testing.T) {
+	domain := &testCore{}
+	s := New(logium.NewLogger("error", "text"), testProviders{
+		"apple": testProvider{postsCallback: true},
+	}, OIDCConfig{}, domain, nil)
+
+	r := chi.NewRouter()
+	r.Get("/sso-svc/v1/login/{provider}", s.LoginBySocialProvider)
+	r.Post("/sso-svc/v1/login/{provider}/callback", s.LoginBySocialProviderCallback)
+
+	begin := httptest.NewRecorder()
+	r.ServeHTTP(begin, httptest.NewRequest(http.MethodGet, "/sso-svc/v1/login/apple", nil))
+
+	cookies := begin.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != socialStateCookieName {
+		t.Fatalf("LoginBySocialProvider: expected the state cookie, got %v", cookies)
+	}
+	cookie := cookies[0]
+	if cookie.SameSite != http.SameSiteNoneMode || !cookie.Secure || !cookie.HttpOnly {
+		t.Fatalf("LoginBySocialProvider: cookie of a posting provider must be SameSite=None, Secure and HttpOnly, got %+v", cookie)
+	}
+
+	callback := func(state string, cookie *http.Cookie) int {
+		form := url.Values{"state": {state}, "code": {"code"}}
+		req := httptest.NewRequest(http.MethodPost, "/sso-svc/v1/login/apple/callback", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	if code := callback("state-value", nil); code != http.StatusBadRequest || len(domain.consumed) != 0 {
+		t.Fatalf("callback without cookie: status %d, consumed %v, want 400 and no state consumed", code, domain.consumed)
+	}
+	if code := callback("other-state", cookie); code != http.StatusBadRequest || len(domain.consumed) != 0 {
+		t.Fatalf("callback with another state: status %d, consumed %v, want 400 and no state consumed", code, domain.consumed)
+	}
+
+	// the cookie is accepted, the fake provider then refuses the code
+	if code := callback("state-value", cookie); code != http.StatusUnauthorized || len(domain.consumed) != 1 {
+		t.Fatalf("callback with cookie: status %d, consumed %v, want the state consumed", code, domain.consumed)
+	}
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/sso-svc/internal/domain/errx"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	"github.com/umisto/sso-svc/internal/rest/meta"
+	"github.com/umisto/sso-svc/internal/rest/requests"
+	"github.com/umisto/sso-svc/internal/rest/responses"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (s *Service) UpdateAccountAdmin(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	req, err := requests.UpdateAccount(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode update account request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	account, err := s.domain.UpdateAccountByAdmin(r.Context(), auth.InitiatorData{
+		AccountID: initiator.ID,
+		SessionID: initiator.SessionID,
+	}, req.Data.Id, auth.UpdateAccountByAdminParams{
+		Status: req.Data.Attributes.Status,
+		Role:   req.Data.Attributes.Role,
+		Reason: req.Data.Attributes.Reason,
+	})
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to update account %s by admin", req.Data.Id)
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorIsNotActive):
+			ape.RenderErr(w, problems.Forbidden("initiator is not active"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorNotEnoughRights):
+			ape.RenderErr(w, problems.Forbidden("only admins can manage accounts"))
+		case errors.Is(err, errx.ErrorCannotManageOwnAccount):
+			ape.RenderErr(w, problems.Forbidden("admins cannot change the status or role of their own account"))
+		case errors.Is(err, errx.ErrorAccountNotFound):
+			ape.RenderErr(w, problems.NotFound("account not found"))
+		case errors.Is(err, errx.ErrorRoleNotSupported):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/role": err,
+			})...)
+		case errors.Is(err, errx.ErrorStatusNotSupported):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/status": err,
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	s.log.Infof("account %s updated by admin %s: %s", account.ID, initiator.ID, req.Data.Attributes.Reason)
+
+	ape.Render(w, http.StatusOK, responses.Account(account))
+}
@@ -0,0 +1,46 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"net/http"
+	"time"
+
+	"github.com/umisto/logium"
+	"github.com/umisto/sso-svc/internal"
+)
+
+// RunMetrics serves the expvar metrics on their own port, apart from the public api.
+func RunMetrics(ctx context.Context, cfg internal.Config, log logium.Logger) {
+	srv := &http.Server{
+		Addr:              cfg.Rest.MetricsPort,
+		Handler:           expvar.Handler(),
+		ReadHeaderTimeout: cfg.Rest.Timeouts.ReadHeader,
+	}
+
+	log.Infof("starting metrics service on %s", cfg.Rest.MetricsPort)
+
+	errCh := make(chan error, 1)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		} else {
+			errCh <- nil
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+	case err := <-errCh:
+		if err != nil {
+			log.Errorf("metrics server error: %v", err)
+		}
+	}
+
+	shCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shCtx); err != nil {
+		log.Errorf("metrics shutdown error: %v", err)
+	}
+}
@@ -1,26 +1,263 @@
 package middlewares
 
 import (
+	"context"
+	"fmt"
+	"net"
 	"net/http"
+	"net/netip"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/google/uuid"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
 	"github.com/umisto/logium"
 	"github.com/umisto/restkit/mdlv"
+	"github.com/umisto/restkit/token"
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+	ssotoken "github.com/umisto/sso-svc/internal/token"
 )
 
+type AccessTokenParser interface {
+	ParseAccess(tokenStr string) (ssotoken.AccountClaims, error)
+}
+
 type Service struct {
-	log logium.Logger
+	log    logium.Logger
+	tokens AccessTokenParser
+	// trustedProxies are the networks whose forwarding headers are believed, the headers
+	// of any other peer are ignored as the client can set them to anything.
+	trustedProxies []netip.Prefix
 }
 
-func New(log logium.Logger) Service {
+func New(log logium.Logger, tokens AccessTokenParser, trustedProxies []netip.Prefix) Service {
 	return Service{
-		log: log,
+		log:            log,
+		tokens:         tokens,
+		trustedProxies: trustedProxies,
+	}
+}
+
+// ParseTrustedProxies parses the CIDRs of the proxies in front of the service, a bare
+// address stands for itself.
+func ParseTrustedProxies(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		if !strings.Contains(cidr, "/") {
+			addr, err := netip.ParseAddr(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("parsing trusted proxy %q: %w", cidr, err)
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("parsing trusted proxy %q: %w", cidr, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
 	}
+
+	return prefixes, nil
 }
 
-func (s Service) Auth(userCtxKey interface{}, skUser string) func(http.Handler) http.Handler {
-	return mdlv.Auth(userCtxKey, skUser)
+// Auth verifies the bearer access token against the signing key ring and puts the
+// account data into the request context. Tokens issued to oauth clients are refused,
+// they are only good for the endpoints of OAuthAuth.
+func (s Service) Auth(userCtxKey interface{}) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := s.accessClaims(w, r)
+			if !ok {
+				return
+			}
+
+			if claims.ClientID != "" {
+				ape.RenderErr(w, problems.Unauthorized("access token of an oauth client is not accepted"))
+				return
+			}
+
+			ctx, ok := s.withAccountData(w, r, userCtxKey, claims)
+			if !ok {
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// OAuthAuth is Auth for the endpoints oauth clients call on behalf of the user, it also
+// accepts the tokens issued to them and puts their grant into the request context.
+func (s Service) OAuthAuth(userCtxKey interface{}) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := s.accessClaims(w, r)
+			if !ok {
+				return
+			}
+
+			ctx, ok := s.withAccountData(w, r, userCtxKey, claims)
+			if !ok {
+				return
+			}
+
+			ctx = auth.WithOAuthGrant(ctx, auth.OAuthGrant{
+				ClientID: claims.ClientID,
+				Scopes:   claims.Scopes(),
+			})
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func (s Service) accessClaims(w http.ResponseWriter, r *http.Request) (ssotoken.AccountClaims, bool) {
+	raw, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || raw == "" {
+		ape.RenderErr(w, problems.Unauthorized("missing bearer access token"))
+		return ssotoken.AccountClaims{}, false
+	}
+
+	claims, err := s.tokens.ParseAccess(raw)
+	if err != nil {
+		s.log.WithError(err).Error("failed to parse access token")
+		ape.RenderErr(w, problems.Unauthorized("invalid access token"))
+		return ssotoken.AccountClaims{}, false
+	}
+
+	return claims, true
+}
+
+func (s Service) withAccountData(
+	w http.ResponseWriter,
+	r *http.Request,
+	userCtxKey interface{},
+	claims ssotoken.AccountClaims,
+) (context.Context, bool) {
+	accountID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		s.log.WithError(err).Error("failed to parse account id from access token")
+		ape.RenderErr(w, problems.Unauthorized("invalid access token"))
+		return nil, false
+	}
+
+	return context.WithValue(r.Context(), userCtxKey, token.AccountData{
+		ID:        accountID,
+		SessionID: claims.SessionID,
+		Role:      claims.Role,
+	}), true
 }
 
 func (s Service) RoleGrant(userCtxKey interface{}, allowedRoles map[string]bool) func(http.Handler) http.Handler {
 	return mdlv.SystemRoleGrant(userCtxKey, allowedRoles)
 }
+
+const (
+	// ClientNameHeader carries the name an app gives itself, like "Cifra for iOS".
+	ClientNameHeader = "X-Client-Name"
+	// GeoHintHeader carries the location of the client resolved by the proxy in front of
+	// the service, the proxy must drop the header sent by the client. It is read only
+	// from trusted proxies.
+	GeoHintHeader = "X-Geo-Hint"
+
+	forwardedForHeader = "X-Forwarded-For"
+	realIPHeader       = "X-Real-IP"
+
+	maxUserAgentLength  = 512
+	maxClientNameLength = 128
+	maxGeoHintLength    = 64
+)
+
+// ClientData puts the address, user agent and the client headers into the request
+// context, the domain records them with the changes and sessions the request makes and
+// locks out the address after failed logins.
+func (s Service) ClientData(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client := auth.ClientData{
+			IP:         remoteIP(r),
+			UserAgent:  truncate(r.UserAgent(), maxUserAgentLength),
+			ClientName: truncate(r.Header.Get(ClientNameHeader), maxClientNameLength),
+		}
+
+		if s.isTrustedProxy(client.IP) {
+			client.IP = s.forwardedIP(r, client.IP)
+			client.GeoHint = truncate(r.Header.Get(GeoHintHeader), maxGeoHintLength)
+		}
+
+		next.ServeHTTP(w, r.WithContext(auth.WithClientData(r.Context(), client)))
+	})
+}
+
+// forwardedIP returns the client address the trusted proxies forwarded. X-Forwarded-For
+// is read from the right, the first address not of a trusted proxy is the client, the
+// ones left of it were sent by the client itself. X-Real-IP is used without the former.
+func (s Service) forwardedIP(r *http.Request, peer string) string {
+	if values := r.Header.Values(forwardedForHeader); len(values) > 0 {
+		hops := strings.Split(strings.Join(values, ","), ",")
+
+		ip := peer
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+			if err != nil {
+				break
+			}
+
+			ip = hop.Unmap().String()
+			if !s.isTrustedProxy(ip) {
+				break
+			}
+		}
+
+		return ip
+	}
+
+	if realIP, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get(realIPHeader))); err == nil {
+		return realIP.Unmap().String()
+	}
+
+	return peer
+}
+
+func (s Service) isTrustedProxy(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, prefix := range s.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// remoteIP returns the address of the peer the connection came from.
+func remoteIP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return ip
+}
+
+// truncate cuts s to at most n bytes without splitting a character.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	s = s[:n]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+
+	return s
+}
@@ -0,0 +1,82 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/umisto/sso-svc/internal/domain/modules/auth"
+)
+
+func TestClientDataIP(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatalf("ParseTrustedProxies: %v", err)
+	}
+	s := New(nil, nil, proxies)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		headers    map[string]string
+		want       string
+	}{
+		{
+			name:       "direct client",
+			remoteAddr: "203.0.113.7:5000",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "untrusted peer forging headers",
+			remoteAddr: "203.0.113.7:5000",
+			headers: map[string]string{
+				forwardedForHeader: "198.51.100.1",
+				realIPHeader:       "198.51.100.2",
+				"True-Client-IP":   "198.51.100.3",
+			},
+			want: "203.0.113.7",
+		},
+		{
+			name:       "trusted proxy",
+			remoteAddr: "10.1.2.3:5000",
+			headers:    map[string]string{forwardedForHeader: "198.51.100.1"},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "client prepending a forged hop",
+			remoteAddr: "10.1.2.3:5000",
+			headers:    map[string]string{forwardedForHeader: "1.2.3.4, 198.51.100.1, 192.168.1.1"},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "trusted proxy with x-real-ip",
+			remoteAddr: "192.168.1.1:5000",
+			headers:    map[string]string{realIPHeader: "198.51.100.2"},
+			want:       "198.51.100.2",
+		},
+		{
+			name:       "trusted proxy without headers",
+			remoteAddr: "10.1.2.3:5000",
+			want:       "10.1.2.3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for k, v := range tt.headers {
+				r.Header.Set(k, v)
+			}
+
+			var got string
+			s.ClientData(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = auth.ClientDataFromCtx(r.Context()).IP
+			})).ServeHTTP(httptest.NewRecorder(), r)
+
+			if got != tt.want {
+				t.Fatalf("client ip: got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
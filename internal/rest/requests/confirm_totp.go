@@ -0,0 +1,24 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func ConfirmTOTP(r *http.Request) (req resources.ConfirmTOTP, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":            validation.Validate(req.Data.Type, validation.Required, validation.In(resources.ConfirmTOTPType)),
+		"data/attributes":      validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/code": validation.Validate(req.Data.Attributes.Code, validation.Required),
+	}
+
+	return req, errs.Filter()
+}
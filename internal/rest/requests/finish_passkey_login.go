@@ -0,0 +1,26 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func FinishPasskeyLogin(r *http.Request) (req resources.FinishPasskeyLogin, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":                    validation.Validate(req.Data.Id.String(), validation.NotIn(uuid.Nil.String())),
+		"data/type":                  validation.Validate(req.Data.Type, validation.Required, validation.In(resources.FinishPasskeyLoginType)),
+		"data/attributes":            validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/credential": validation.Validate(req.Data.Attributes.Credential, validation.Required),
+	}
+
+	return req, errs.Filter()
+}
@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func FinishPasskeyRegistration(r *http.Request) (req resources.FinishPasskeyRegistration, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":                    validation.Validate(req.Data.Id.String(), validation.NotIn(uuid.Nil.String())),
+		"data/type":                  validation.Validate(req.Data.Type, validation.Required, validation.In(resources.FinishPasskeyRegistrationType)),
+		"data/attributes":            validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/name":       validation.Validate(req.Data.Attributes.Name, validation.Required, validation.Length(1, 64)),
+		"data/attributes/credential": validation.Validate(req.Data.Attributes.Credential, validation.Required),
+	}
+
+	return req, errs.Filter()
+}
@@ -0,0 +1,26 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func ForgotPassword(r *http.Request) (req resources.ForgotPassword, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In(resources.ForgotPasswordType)),
+		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/email": validation.Validate(
+			req.Data.Attributes.Email, validation.Required, validation.Length(5, 255), is.Email),
+	}
+
+	return req, errs.Filter()
+}
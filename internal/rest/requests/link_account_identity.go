@@ -0,0 +1,23 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func LinkAccountIdentity(r *http.Request) (req resources.LinkAccountIdentity, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":                validation.Validate(req.Data.Type, validation.Required, validation.In(resources.LinkAccountIdentityType)),
+		"data/attributes/provider": validation.Validate(req.Data.Attributes.Provider, validation.Required),
+	}
+
+	return req, errs.Filter()
+}
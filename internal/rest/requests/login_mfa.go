@@ -0,0 +1,25 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func LoginMFA(r *http.Request) (req resources.LoginMFA, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":                 validation.Validate(req.Data.Type, validation.Required, validation.In(resources.LoginMFAType)),
+		"data/attributes":           validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/challenge": validation.Validate(req.Data.Attributes.Challenge, validation.Required),
+		"data/attributes/code":      validation.Validate(req.Data.Attributes.Code, validation.Required),
+	}
+
+	return req, errs.Filter()
+}
@@ -0,0 +1,26 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func OAuthAuthorize(r *http.Request) (req resources.OAuthAuthorize, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":                             validation.Validate(req.Data.Type, validation.Required, validation.In(resources.OAuthAuthorizeType)),
+		"data/attributes/client_id":             validation.Validate(req.Data.Attributes.ClientId, validation.Required),
+		"data/attributes/redirect_uri":          validation.Validate(req.Data.Attributes.RedirectUri, validation.Required),
+		"data/attributes/scope":                 validation.Validate(req.Data.Attributes.Scope, validation.Required),
+		"data/attributes/code_challenge":        validation.Validate(req.Data.Attributes.CodeChallenge, validation.Required),
+		"data/attributes/code_challenge_method": validation.Validate(req.Data.Attributes.CodeChallengeMethod, validation.Required),
+	}
+	return req, errs.Filter()
+}
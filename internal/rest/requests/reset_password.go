@@ -0,0 +1,25 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func ResetPassword(r *http.Request) (req resources.ResetPassword, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":                    validation.Validate(req.Data.Type, validation.Required, validation.In(resources.ResetPasswordType)),
+		"data/attributes":              validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/token":        validation.Validate(req.Data.Attributes.Token, validation.Required),
+		"data/attributes/new_password": validation.Validate(req.Data.Attributes.NewPassword, validation.Required),
+	}
+
+	return req, errs.Filter()
+}
@@ -0,0 +1,24 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func RevokeSessions(r *http.Request) (req resources.RevokeSessions, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":              validation.Validate(req.Data.Type, validation.Required, validation.In(resources.RevokeSessionsType)),
+		"data/attributes/reason": validation.Validate(req.Data.Attributes.Reason, validation.Required, validation.Length(1, 255)),
+		"data/attributes/role":   validation.Validate(req.Data.Attributes.Role, validation.NilOrNotEmpty),
+	}
+
+	return req, errs.Filter()
+}
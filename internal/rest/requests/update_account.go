@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func UpdateAccount(r *http.Request) (req resources.UpdateAccount, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":              validation.Validate(req.Data.Type, validation.Required, validation.In(resources.UpdateAccountType)),
+		"data/attributes/reason": validation.Validate(req.Data.Attributes.Reason, validation.Required, validation.Length(1, 255)),
+		"data/attributes/role":   validation.Validate(req.Data.Attributes.Role, validation.NilOrNotEmpty),
+		"data/attributes/status": validation.Validate(req.Data.Attributes.Status, validation.NilOrNotEmpty),
+	}
+
+	if req.Data.Id.String() != chi.URLParam(r, "account_id") {
+		errs["data/id"] = fmt.Errorf("does not match the account id in the path")
+	}
+
+	return req, errs.Filter()
+}
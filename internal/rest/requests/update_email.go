@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func UpdateEmail(r *http.Request) (req resources.UpdateEmail, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In(resources.UpdateEmailType)),
+		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/new_email": validation.Validate(
+			req.Data.Attributes.NewEmail, validation.Required, validation.Length(5, 255), is.Email),
+		"data/attributes/password": validation.Validate(req.Data.Attributes.Password, validation.Required),
+	}
+
+	return req, errs.Filter()
+}
@@ -22,3 +22,20 @@ func Account(m entity.Account) resources.Account {
 
 	return resp
 }
+
+func AccountsCollection(ms entity.AccountsCollection) resources.AccountsCollection {
+	items := make([]resources.AccountData, 0, len(ms.Data))
+
+	for _, a := range ms.Data {
+		items = append(items, Account(a).Data)
+	}
+
+	return resources.AccountsCollection{
+		Data: items,
+		Links: resources.PaginationData{
+			PageNumber: int64(ms.Page),
+			PageSize:   int64(ms.Size),
+			TotalItems: int64(ms.Total),
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package responses
+
+import (
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func AccountIdentity(m entity.AccountIdentity) resources.AccountIdentity {
+	resp := resources.AccountIdentity{
+		Data: resources.AccountIdentityData{
+			Id:   m.ID,
+			Type: resources.AccountIdentityType,
+			Attributes: resources.AccountIdentityAttributes{
+				Provider: m.Provider,
+				Subject:  m.Subject,
+				LinkedAt: m.LinkedAt,
+			},
+		},
+	}
+	if m.Email != "" {
+		resp.Data.Attributes.Email = &m.Email
+	}
+
+	return resp
+}
+
+func AccountIdentitiesCollection(ms []entity.AccountIdentity) resources.AccountIdentitiesCollection {
+	items := make([]resources.AccountIdentityData, 0, len(ms))
+
+	for _, i := range ms {
+		items = append(items, AccountIdentity(i).Data)
+	}
+
+	return resources.AccountIdentitiesCollection{
+		Data: items,
+	}
+}
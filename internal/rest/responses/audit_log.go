@@ -0,0 +1,57 @@
+package responses
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func AuditLogEntry(m entity.AuditLogEntry) resources.AuditLogEntryData {
+	resp := resources.AuditLogEntryData{
+		Id:   m.ID,
+		Type: resources.AuditLogEntryType,
+		Attributes: resources.AuditLogEntryAttributes{
+			Action:    m.Action,
+			Ip:        m.IP,
+			UserAgent: m.UserAgent,
+			Metadata:  map[string]interface{}{},
+			CreatedAt: m.CreatedAt,
+		},
+	}
+
+	if m.ActorID != uuid.Nil {
+		resp.Attributes.ActorId = &m.ActorID
+	}
+	if m.TargetID != uuid.Nil {
+		resp.Attributes.TargetId = &m.TargetID
+	}
+	if len(m.Metadata) > 0 {
+		// the metadata is stored as a json object, it always decodes
+		_ = json.Unmarshal(m.Metadata, &resp.Attributes.Metadata)
+	}
+
+	return resp
+}
+
+func AuditLogEntriesCollection(ms entity.AuditLogPage) resources.AuditLogEntriesCollection {
+	items := make([]resources.AuditLogEntryData, 0, len(ms.Data))
+
+	for _, e := range ms.Data {
+		items = append(items, AuditLogEntry(e))
+	}
+
+	links := resources.CursorPaginationData{
+		Limit: int64(ms.Limit),
+	}
+	if ms.NextCursor != uuid.Nil {
+		cursor := ms.NextCursor.String()
+		links.NextCursor = &cursor
+	}
+
+	return resources.AuditLogEntriesCollection{
+		Data:  items,
+		Links: links,
+	}
+}
@@ -0,0 +1,18 @@
+package responses
+
+import (
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func MFAChallenge(m entity.MFAChallenge) resources.MFAChallenge {
+	return resources.MFAChallenge{
+		Data: resources.MFAChallengeData{
+			Type: resources.MFAChallengeType,
+			Attributes: resources.MFAChallengeDataAttributes{
+				Challenge: m.Token,
+				ExpiresAt: m.ExpiresAt,
+			},
+		},
+	}
+}
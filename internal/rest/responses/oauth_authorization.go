@@ -0,0 +1,16 @@
+package responses
+
+import (
+	"github.com/umisto/sso-svc/resources"
+)
+
+func OAuthAuthorization(redirectURI string) resources.OAuthAuthorization {
+	return resources.OAuthAuthorization{
+		Data: resources.OAuthAuthorizationData{
+			Type: resources.OAuthAuthorizationType,
+			Attributes: resources.OAuthAuthorizationDataAttributes{
+				RedirectUri: redirectURI,
+			},
+		},
+	}
+}
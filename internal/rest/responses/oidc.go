@@ -0,0 +1,137 @@
+package responses
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+// The openid connect endpoints answer with the plain json documents defined by the
+// specifications instead of json:api resources.
+
+type OpenIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+type OAuthTokens struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+func NewOAuthTokens(m entity.OAuthTokens, accessTTL time.Duration) OAuthTokens {
+	return OAuthTokens{
+		AccessToken:  m.Access,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(accessTTL.Seconds()),
+		RefreshToken: m.Refresh,
+		IDToken:      m.IDToken,
+		Scope:        strings.Join(m.Scopes, " "),
+	}
+}
+
+// UserInfo is the userinfo response, it holds only the claims the scopes of the access
+// token release.
+type UserInfo struct {
+	Subject           string `json:"sub"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     *bool  `json:"email_verified,omitempty"`
+}
+
+func NewUserInfo(m entity.UserInfo) UserInfo {
+	return UserInfo{
+		Subject:           m.Subject.String(),
+		PreferredUsername: m.Username,
+		Email:             m.Email,
+		EmailVerified:     m.EmailVerified,
+	}
+}
+
+// TokenIntrospection is the introspection response, RFC 7662 section 2.2. Inactive
+// tokens are answered with the active member alone.
+type TokenIntrospection struct {
+	Active    bool   `json:"active"`
+	TokenType string `json:"token_type,omitempty"`
+	Subject   string `json:"sub,omitempty"`
+	Username  string `json:"username,omitempty"`
+	Issuer    string `json:"iss,omitempty"`
+	IssuedAt  int64  `json:"iat,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+	SessionID string `json:"session_id,omitempty"`
+	Role      string `json:"role,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Scope     string `json:"scope,omitempty"`
+}
+
+func NewTokenIntrospection(m entity.TokenIntrospection) TokenIntrospection {
+	if !m.Active {
+		return TokenIntrospection{}
+	}
+
+	return TokenIntrospection{
+		Active:    true,
+		TokenType: m.TokenType,
+		Subject:   m.AccountID.String(),
+		Username:  m.Username,
+		Issuer:    m.Issuer,
+		IssuedAt:  m.IssuedAt.Unix(),
+		ExpiresAt: m.ExpiresAt.Unix(),
+		SessionID: m.SessionID.String(),
+		Role:      m.Role,
+		ClientID:  m.ClientID,
+		Scope:     strings.Join(m.Scopes, " "),
+	}
+}
+
+// OAuthError is the error body of the token endpoint, RFC 6749 section 5.2.
+type OAuthError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+func NewOpenIDConfiguration(issuer string, algorithms []string) OpenIDConfiguration {
+	base := strings.TrimSuffix(issuer, "/")
+
+	slices.Sort(algorithms)
+
+	return OpenIDConfiguration{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             base + "/sso-svc/v1/oauth/authorize",
+		TokenEndpoint:                     base + "/sso-svc/v1/oauth/token",
+		UserInfoEndpoint:                  base + "/sso-svc/v1/oauth/userinfo",
+		IntrospectionEndpoint:             base + "/sso-svc/v1/oauth/introspect",
+		RevocationEndpoint:                base + "/sso-svc/v1/oauth/revoke",
+		JWKSURI:                           base + "/.well-known/jwks.json",
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code", "refresh_token"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  slices.Compact(algorithms),
+		ScopesSupported:                   entity.OAuthScopes,
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{entity.PKCEMethodS256},
+		ClaimsSupported: []string{
+			"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce",
+			"preferred_username", "email", "email_verified",
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package responses
+
+import (
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func Passkey(m entity.Passkey) resources.Passkey {
+	transports := m.Transports
+	if transports == nil {
+		transports = []string{}
+	}
+
+	return resources.Passkey{
+		Data: resources.PasskeyData{
+			Id:   m.ID,
+			Type: resources.PasskeyType,
+			Attributes: resources.PasskeyAttributes{
+				Name:           m.Name,
+				Transports:     transports,
+				BackupEligible: m.BackupEligible,
+				BackupState:    m.BackupState,
+				LastUsedAt:     m.LastUsedAt,
+				CreatedAt:      m.CreatedAt,
+			},
+		},
+	}
+}
+
+func PasskeysCollection(ms []entity.Passkey) resources.PasskeysCollection {
+	items := make([]resources.PasskeyData, 0, len(ms))
+
+	for _, p := range ms {
+		items = append(items, Passkey(p).Data)
+	}
+
+	return resources.PasskeysCollection{
+		Data: items,
+	}
+}
@@ -0,0 +1,25 @@
+package responses
+
+import (
+	"encoding/json"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func PasskeyCeremony(m entity.PasskeyCeremony) resources.PasskeyCeremony {
+	// options are marshaled by the relying party from a struct, so they always decode
+	var options map[string]interface{}
+	_ = json.Unmarshal(m.Options, &options)
+
+	return resources.PasskeyCeremony{
+		Data: resources.PasskeyCeremonyData{
+			Id:   m.ID,
+			Type: resources.PasskeyCeremonyType,
+			Attributes: resources.PasskeyCeremonyDataAttributes{
+				Options:   options,
+				ExpiresAt: m.ExpiresAt,
+			},
+		},
+	}
+}
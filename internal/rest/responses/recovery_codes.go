@@ -0,0 +1,18 @@
+package responses
+
+import (
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func RecoveryCodes(accountID uuid.UUID, codes []string) resources.RecoveryCodes {
+	return resources.RecoveryCodes{
+		Data: resources.RecoveryCodesData{
+			Id:   accountID,
+			Type: resources.RecoveryCodesType,
+			Attributes: resources.RecoveryCodesDataAttributes{
+				Codes: codes,
+			},
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/umisto/sso-svc/resources"
+)
+
+func SocialAuthorization(authorizationURL string, expiresAt time.Time) resources.SocialAuthorization {
+	return resources.SocialAuthorization{
+		Data: resources.SocialAuthorizationData{
+			Type: resources.SocialAuthorizationType,
+			Attributes: resources.SocialAuthorizationDataAttributes{
+				AuthorizationUrl: authorizationURL,
+				ExpiresAt:        expiresAt,
+			},
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package responses
+
+import (
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"github.com/umisto/sso-svc/resources"
+)
+
+func TOTPSetup(accountID uuid.UUID, m entity.TOTPSetup) resources.TOTPSetup {
+	return resources.TOTPSetup{
+		Data: resources.TOTPSetupData{
+			Id:   accountID,
+			Type: resources.TOTPSetupType,
+			Attributes: resources.TOTPSetupDataAttributes{
+				Secret: m.Secret,
+				Uri:    m.URI,
+			},
+		},
+	}
+}
@@ -14,10 +14,22 @@ func AccountSession(m entity.Session) resources.AccountSession {
 				AccountId: m.AccountID,
 				CreatedAt: m.CreatedAt,
 				LastUsed:  m.LastUsed,
+				Ip:        m.IP,
+				UserAgent: m.UserAgent,
+				Device:    m.Device,
+				Os:        m.OS,
+				Browser:   m.Browser,
 			},
 		},
 	}
 
+	if m.ClientName != "" {
+		resp.Data.Attributes.ClientName = &m.ClientName
+	}
+	if m.GeoHint != "" {
+		resp.Data.Attributes.GeoHint = &m.GeoHint
+	}
+
 	return resp
 }
 
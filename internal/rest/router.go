@@ -17,10 +17,22 @@ type Handlers interface {
 	Registration(w http.ResponseWriter, r *http.Request)
 	RegistrationAdmin(w http.ResponseWriter, r *http.Request)
 
+	GetAccountsAdmin(w http.ResponseWriter, r *http.Request)
+	GetAccountAdmin(w http.ResponseWriter, r *http.Request)
+	UpdateAccountAdmin(w http.ResponseWriter, r *http.Request)
+	GetAccountSessionsAdmin(w http.ResponseWriter, r *http.Request)
+	DeleteAccountSessionsAdmin(w http.ResponseWriter, r *http.Request)
+	DeleteAccountSessionAdmin(w http.ResponseWriter, r *http.Request)
+	RevokeSessionsAdmin(w http.ResponseWriter, r *http.Request)
+	GetAuditLogAdmin(w http.ResponseWriter, r *http.Request)
+
 	LoginByEmail(w http.ResponseWriter, r *http.Request)
 	LoginByUsername(w http.ResponseWriter, r *http.Request)
-	LoginByGoogleOAuth(w http.ResponseWriter, r *http.Request)
-	LoginByGoogleOAuthCallback(w http.ResponseWriter, r *http.Request)
+	LoginByMFA(w http.ResponseWriter, r *http.Request)
+	BeginPasskeyLogin(w http.ResponseWriter, r *http.Request)
+	FinishPasskeyLogin(w http.ResponseWriter, r *http.Request)
+	LoginBySocialProvider(w http.ResponseWriter, r *http.Request)
+	LoginBySocialProviderCallback(w http.ResponseWriter, r *http.Request)
 
 	Logout(w http.ResponseWriter, r *http.Request)
 
@@ -30,27 +42,65 @@ type Handlers interface {
 	GetMySession(w http.ResponseWriter, r *http.Request)
 	GetMySessions(w http.ResponseWriter, r *http.Request)
 	GetMyEmailData(w http.ResponseWriter, r *http.Request)
+	GetMySecurityActivity(w http.ResponseWriter, r *http.Request)
+
+	VerifyMyEmail(w http.ResponseWriter, r *http.Request)
+	ResendMyEmailVerification(w http.ResponseWriter, r *http.Request)
+	UpdateMyEmail(w http.ResponseWriter, r *http.Request)
+	ConfirmMyEmailUpdate(w http.ResponseWriter, r *http.Request)
+
+	SetupMyTOTP(w http.ResponseWriter, r *http.Request)
+	ConfirmMyTOTP(w http.ResponseWriter, r *http.Request)
+	DisableMyTOTP(w http.ResponseWriter, r *http.Request)
+
+	GetMyPasskeys(w http.ResponseWriter, r *http.Request)
+	BeginMyPasskeyRegistration(w http.ResponseWriter, r *http.Request)
+	FinishMyPasskeyRegistration(w http.ResponseWriter, r *http.Request)
+	DeleteMyPasskey(w http.ResponseWriter, r *http.Request)
+
+	GetMyIdentities(w http.ResponseWriter, r *http.Request)
+	LinkMyIdentity(w http.ResponseWriter, r *http.Request)
+	UnlinkMyIdentity(w http.ResponseWriter, r *http.Request)
 
 	UpdatePassword(w http.ResponseWriter, r *http.Request)
 	UpdateUsername(w http.ResponseWriter, r *http.Request)
 
+	ForgotPassword(w http.ResponseWriter, r *http.Request)
+	ResetPassword(w http.ResponseWriter, r *http.Request)
+
 	DeleteMyAccount(w http.ResponseWriter, r *http.Request)
 	DeleteMySession(w http.ResponseWriter, r *http.Request)
 	DeleteMySessions(w http.ResponseWriter, r *http.Request)
+
+	GetJWKS(w http.ResponseWriter, r *http.Request)
+	GetOpenIDConfiguration(w http.ResponseWriter, r *http.Request)
+	OAuthAuthorize(w http.ResponseWriter, r *http.Request)
+	ApproveOAuthAuthorization(w http.ResponseWriter, r *http.Request)
+	OAuthToken(w http.ResponseWriter, r *http.Request)
+	GetUserInfo(w http.ResponseWriter, r *http.Request)
+	OAuthIntrospect(w http.ResponseWriter, r *http.Request)
+	OAuthRevoke(w http.ResponseWriter, r *http.Request)
 }
 
 type Middlewares interface {
-	Auth(userCtxKey interface{}, skUser string) func(http.Handler) http.Handler
+	Auth(userCtxKey interface{}) func(http.Handler) http.Handler
+	OAuthAuth(userCtxKey interface{}) func(http.Handler) http.Handler
 	RoleGrant(userCtxKey interface{}, allowedRoles map[string]bool) func(http.Handler) http.Handler
+	ClientData(next http.Handler) http.Handler
 }
 
 func Run(ctx context.Context, cfg internal.Config, log logium.Logger, m Middlewares, h Handlers) {
-	auth := m.Auth(meta.AccountDataCtxKey, cfg.JWT.User.AccessToken.SecretKey)
+	auth := m.Auth(meta.AccountDataCtxKey)
+	oauth := m.OAuthAuth(meta.AccountDataCtxKey)
 	sysadmin := m.RoleGrant(meta.AccountDataCtxKey, map[string]bool{
 		roles.SystemAdmin: true,
 	})
 
 	r := chi.NewRouter()
+	r.Use(m.ClientData)
+
+	r.Get("/.well-known/jwks.json", h.GetJWKS)
+	r.Get("/.well-known/openid-configuration", h.GetOpenIDConfiguration)
 
 	r.Route("/sso-svc", func(r chi.Router) {
 		r.Route("/v1", func(r chi.Router) {
@@ -59,24 +109,71 @@ func Run(ctx context.Context, cfg internal.Config, log logium.Logger, m Middlewa
 			r.Route("/login", func(r chi.Router) {
 				r.Post("/email", h.LoginByEmail)
 				r.Post("/username", h.LoginByUsername)
+				r.Post("/mfa", h.LoginByMFA)
+
+				r.Route("/passkey", func(r chi.Router) {
+					r.Post("/", h.BeginPasskeyLogin)
+					r.Post("/finish", h.FinishPasskeyLogin)
+				})
 
-				r.Route("/google", func(r chi.Router) {
-					r.Post("/", h.LoginByGoogleOAuth)
-					r.Post("/callback", h.LoginByGoogleOAuthCallback)
+				r.Route("/{provider}", func(r chi.Router) {
+					r.Get("/", h.LoginBySocialProvider)
+					r.Post("/", h.LoginBySocialProvider)
+					r.Get("/callback", h.LoginBySocialProviderCallback)
+					r.Post("/callback", h.LoginBySocialProviderCallback)
 				})
 			})
 
 			r.Post("/refresh", h.RefreshSession)
 
+			r.Route("/oauth", func(r chi.Router) {
+				r.Get("/authorize", h.OAuthAuthorize)
+				r.With(auth).Post("/authorize", h.ApproveOAuthAuthorization)
+				r.Post("/token", h.OAuthToken)
+				r.Post("/introspect", h.OAuthIntrospect)
+				r.Post("/revoke", h.OAuthRevoke)
+				r.With(oauth).Get("/userinfo", h.GetUserInfo)
+				r.With(oauth).Post("/userinfo", h.GetUserInfo)
+			})
+
+			r.Route("/password", func(r chi.Router) {
+				r.Post("/forgot", h.ForgotPassword)
+				r.Post("/reset", h.ResetPassword)
+			})
+
 			r.With(auth).Route("/me", func(r chi.Router) {
 				r.With(auth).Get("/", h.GetMyAccount)
 				r.With(auth).Delete("/", h.DeleteMyAccount)
 
 				r.With(auth).Get("/email", h.GetMyEmailData)
+				r.With(auth).Get("/security-activity", h.GetMySecurityActivity)
+				r.With(auth).Post("/email", h.UpdateMyEmail)
+				r.With(auth).Post("/email/confirm", h.ConfirmMyEmailUpdate)
+				r.With(auth).Post("/email/verify", h.VerifyMyEmail)
+				r.With(auth).Post("/email/verify/resend", h.ResendMyEmailVerification)
 				r.With(auth).Post("/logout", h.Logout)
 				r.With(auth).Post("/password", h.UpdatePassword)
 				r.With(auth).Post("/username", h.UpdateUsername)
 
+				r.With(auth).Route("/2fa/totp", func(r chi.Router) {
+					r.Post("/", h.SetupMyTOTP)
+					r.Post("/confirm", h.ConfirmMyTOTP)
+					r.Post("/disable", h.DisableMyTOTP)
+				})
+
+				r.With(auth).Route("/passkeys", func(r chi.Router) {
+					r.Get("/", h.GetMyPasskeys)
+					r.Post("/", h.BeginMyPasskeyRegistration)
+					r.Post("/finish", h.FinishMyPasskeyRegistration)
+					r.Delete("/{passkey_id}", h.DeleteMyPasskey)
+				})
+
+				r.With(auth).Route("/identities", func(r chi.Router) {
+					r.Get("/", h.GetMyIdentities)
+					r.Post("/", h.LinkMyIdentity)
+					r.Delete("/{identity_id}", h.UnlinkMyIdentity)
+				})
+
 				r.With(auth).Route("/sessions", func(r chi.Router) {
 					r.Get("/", h.GetMySessions)
 					r.Delete("/", h.DeleteMySessions)
@@ -93,6 +190,24 @@ func Run(ctx context.Context, cfg internal.Config, log logium.Logger, m Middlewa
 				r.Use(sysadmin)
 
 				r.Post("/", h.RegistrationAdmin)
+
+				r.Route("/accounts", func(r chi.Router) {
+					r.Get("/", h.GetAccountsAdmin)
+
+					r.Route("/{account_id}", func(r chi.Router) {
+						r.Get("/", h.GetAccountAdmin)
+						r.Patch("/", h.UpdateAccountAdmin)
+
+						r.Route("/sessions", func(r chi.Router) {
+							r.Get("/", h.GetAccountSessionsAdmin)
+							r.Delete("/", h.DeleteAccountSessionsAdmin)
+							r.Delete("/{session_id}", h.DeleteAccountSessionAdmin)
+						})
+					})
+				})
+
+				r.Post("/sessions/revoke", h.RevokeSessionsAdmin)
+				r.Get("/audit", h.GetAuditLogAdmin)
 			})
 		})
 	})
@@ -0,0 +1,137 @@
+package social
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+)
+
+// githubProvider logs in with github, which speaks plain oauth2 without id tokens. The
+// user is read from the rest api instead.
+type githubProvider struct {
+	name   string
+	oauth  oauth2.Config
+	apiURL string
+}
+
+func newGitHubProvider(cfg Config) *githubProvider {
+	endpoint := github.Endpoint
+	if cfg.AuthURL != "" {
+		endpoint.AuthURL = cfg.AuthURL
+	}
+	if cfg.TokenURL != "" {
+		endpoint.TokenURL = cfg.TokenURL
+	}
+
+	apiURL := cfg.APIURL
+	if apiURL == "" {
+		apiURL = "https://api.github.com"
+	}
+
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"read:user", "user:email"}
+	}
+
+	return &githubProvider{
+		name: cfg.Name,
+		oauth: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       scopes,
+			Endpoint:     endpoint,
+		},
+		apiURL: strings.TrimSuffix(apiURL, "/"),
+	}
+}
+
+func (p *githubProvider) Name() string {
+	return p.name
+}
+
+func (p *githubProvider) PostsCallback() bool {
+	return false
+}
+
+// AuthCodeURL has no nonce, github issues no id token.
+func (p *githubProvider) AuthCodeURL(params AuthParams) string {
+	return p.oauth.AuthCodeURL(params.State, oauth2.S256ChallengeOption(params.CodeVerifier))
+}
+
+// Exchange redeems the code and reads the user with the primary email address. Github
+// reports per address whether it is verified, the public profile email is not used.
+func (p *githubProvider) Exchange(ctx context.Context, code string, params AuthParams) (entity.SocialIdentity, error) {
+	token, err := p.oauth.Exchange(ctx, code, oauth2.VerifierOption(params.CodeVerifier))
+	if err != nil {
+		return entity.SocialIdentity{}, fmt.Errorf("exchange code: %w", err)
+	}
+
+	client := p.oauth.Client(ctx, token)
+
+	var user struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+		Name  string `json:"name"`
+	}
+	if err = getJSON(ctx, client, p.apiURL+"/user", &user); err != nil {
+		return entity.SocialIdentity{}, err
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err = getJSON(ctx, client, p.apiURL+"/user/emails", &emails); err != nil {
+		return entity.SocialIdentity{}, err
+	}
+
+	identity := entity.SocialIdentity{
+		Provider: p.name,
+		Subject:  strconv.FormatInt(user.ID, 10),
+		Name:     user.Name,
+		Username: user.Login,
+	}
+
+	for _, email := range emails {
+		if email.Primary {
+			identity.Email = strings.ToLower(email.Email)
+			identity.EmailVerified = email.Verified
+			break
+		}
+	}
+
+	return identity, nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, dst interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("build request %s: %w", url, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("get %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get %s: unexpected status %s", url, resp.Status)
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(dst); err != nil {
+		return fmt.Errorf("decode %s: %w", url, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package social
+
+import (
+	"context"
+	"fmt"
+)
+
+const microsoftLoginURL = "https://login.microsoftonline.com/"
+
+// newMicrosoftProvider creates a microsoft identity platform provider. The common,
+// organizations and consumers tenants accept accounts of many tenants, tokens then carry
+// the issuer of the tenant of the user, which is checked against its tid claim.
+func newMicrosoftProvider(ctx context.Context, cfg Config) (Provider, error) {
+	tenant := cfg.Tenant
+	if tenant == "" {
+		tenant = "common"
+	}
+
+	cfg = withIssuer(cfg, microsoftLoginURL+tenant+"/v2.0")
+
+	switch tenant {
+	case "common", "organizations", "consumers":
+		return newOIDCProvider(ctx, cfg, oidcOptions{
+			checkIssuer: func(issuer string, claims oidcClaims) error {
+				if claims.TenantID == "" || issuer != microsoftLoginURL+claims.TenantID+"/v2.0" {
+					return fmt.Errorf("id token issuer %q does not match tenant %q", issuer, claims.TenantID)
+				}
+
+				return nil
+			},
+		})
+	default:
+		return newOIDCProvider(ctx, cfg, oidcOptions{})
+	}
+}
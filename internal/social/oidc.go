@@ -0,0 +1,172 @@
+package social
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+	"golang.org/x/oauth2"
+)
+
+type oidcOptions struct {
+	// formPost asks the provider to post the callback instead of redirecting to it.
+	formPost bool
+	// checkIssuer replaces the issuer check of the verifier, for multi-tenant issuers
+	// whose tokens carry the issuer of the tenant.
+	checkIssuer func(issuer string, claims oidcClaims) error
+}
+
+type oidcProvider struct {
+	name     string
+	oauth    oauth2.Config
+	provider *oidc.Provider
+	verifier *oidc.IDTokenVerifier
+	opts     oidcOptions
+}
+
+func newOIDCProvider(ctx context.Context, cfg Config, opts oidcOptions) (*oidcProvider, error) {
+	if opts.checkIssuer != nil {
+		ctx = oidc.InsecureIssuerURLContext(ctx, cfg.Issuer)
+	}
+
+	provider, err := oidc.NewProvider(ctx, cfg.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("discover issuer %s: %w", cfg.Issuer, err)
+	}
+
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{oidc.ScopeOpenID, "email", "profile"}
+	}
+
+	return &oidcProvider{
+		name: cfg.Name,
+		oauth: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       scopes,
+			Endpoint:     provider.Endpoint(),
+		},
+		provider: provider,
+		verifier: provider.Verifier(&oidc.Config{
+			ClientID:        cfg.ClientID,
+			SkipIssuerCheck: opts.checkIssuer != nil,
+		}),
+		opts: opts,
+	}, nil
+}
+
+func (p *oidcProvider) Name() string {
+	return p.name
+}
+
+func (p *oidcProvider) PostsCallback() bool {
+	return p.opts.formPost
+}
+
+func (p *oidcProvider) AuthCodeURL(params AuthParams) string {
+	opts := []oauth2.AuthCodeOption{
+		oauth2.S256ChallengeOption(params.CodeVerifier),
+		oidc.Nonce(params.Nonce),
+	}
+	if p.opts.formPost {
+		opts = append(opts, oauth2.SetAuthURLParam("response_mode", "form_post"))
+	}
+
+	return p.oauth.AuthCodeURL(params.State, opts...)
+}
+
+// Exchange redeems the code and verifies the id token. Claims missing from the id token
+// are taken from the userinfo endpoint when the provider has one.
+func (p *oidcProvider) Exchange(ctx context.Context, code string, params AuthParams) (entity.SocialIdentity, error) {
+	token, err := p.oauth.Exchange(ctx, code, oauth2.VerifierOption(params.CodeVerifier))
+	if err != nil {
+		return entity.SocialIdentity{}, fmt.Errorf("exchange code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return entity.SocialIdentity{}, fmt.Errorf("token response has no id token")
+	}
+
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return entity.SocialIdentity{}, fmt.Errorf("verify id token: %w", err)
+	}
+	if idToken.Nonce != params.Nonce {
+		return entity.SocialIdentity{}, fmt.Errorf("id token nonce does not match the authorization request")
+	}
+
+	var claims oidcClaims
+	if err = idToken.Claims(&claims); err != nil {
+		return entity.SocialIdentity{}, fmt.Errorf("decode id token claims: %w", err)
+	}
+
+	if p.opts.checkIssuer != nil {
+		if err = p.opts.checkIssuer(idToken.Issuer, claims); err != nil {
+			return entity.SocialIdentity{}, err
+		}
+	}
+
+	if claims.Email == "" && p.provider.UserInfoEndpoint() != "" {
+		info, err := p.provider.UserInfo(ctx, oauth2.StaticTokenSource(token))
+		if err != nil {
+			return entity.SocialIdentity{}, fmt.Errorf("get userinfo: %w", err)
+		}
+		if info.Subject != idToken.Subject {
+			return entity.SocialIdentity{}, fmt.Errorf("userinfo subject does not match the id token")
+		}
+
+		if err = info.Claims(&claims); err != nil {
+			return entity.SocialIdentity{}, fmt.Errorf("decode userinfo claims: %w", err)
+		}
+	}
+
+	username := claims.PreferredUsername
+	if username == "" {
+		username = claims.Nickname
+	}
+
+	return entity.SocialIdentity{
+		Provider:      p.name,
+		Subject:       idToken.Subject,
+		Email:         strings.ToLower(claims.Email),
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+		Username:      username,
+	}, nil
+}
+
+type oidcClaims struct {
+	Email             string   `json:"email"`
+	EmailVerified     flexBool `json:"email_verified"`
+	Name              string   `json:"name"`
+	PreferredUsername string   `json:"preferred_username"`
+	Nickname          string   `json:"nickname"`
+	TenantID          string   `json:"tid"`
+}
+
+// flexBool accepts booleans sent as json strings, apple does so for email_verified.
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	switch t := v.(type) {
+	case bool:
+		*b = flexBool(t)
+	case string:
+		*b = flexBool(t == "true")
+	default:
+		*b = false
+	}
+
+	return nil
+}
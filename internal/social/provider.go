@@ -0,0 +1,128 @@
+package social
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+const (
+	TypeOIDC      = "oidc"
+	TypeGoogle    = "google"
+	TypeMicrosoft = "microsoft"
+	TypeApple     = "apple"
+	TypeGitLab    = "gitlab"
+	TypeGitHub    = "github"
+)
+
+// Provider is an external login provider. AuthCodeURL starts the authorization code
+// flow, Exchange finishes it and describes the user in a provider agnostic way.
+// PostsCallback tells whether the provider posts the callback from its own site instead
+// of redirecting the user agent to it.
+type Provider interface {
+	Name() string
+	PostsCallback() bool
+	AuthCodeURL(params AuthParams) string
+	Exchange(ctx context.Context, code string, params AuthParams) (entity.SocialIdentity, error)
+}
+
+// AuthParams binds the callback to the authorization request that started the flow, the
+// same values must be passed to AuthCodeURL and Exchange.
+type AuthParams struct {
+	State string
+	// CodeVerifier is the PKCE verifier, the provider gets its S256 challenge.
+	CodeVerifier string
+	// Nonce is copied into the id token by openid providers and checked on exchange.
+	Nonce string
+}
+
+type Config struct {
+	// Name identifies the provider in the login routes.
+	Name string
+	Type string
+
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+
+	// Issuer is required for the oidc type, the other types default to the public
+	// issuer of the provider.
+	Issuer string
+	// Tenant of a microsoft login, common, organizations, consumers or a tenant id.
+	Tenant string
+
+	// AuthURL, TokenURL and APIURL override the github endpoints, for github enterprise.
+	AuthURL  string
+	TokenURL string
+	APIURL   string
+}
+
+type Registry struct {
+	providers map[string]Provider
+}
+
+// NewRegistry builds every configured provider. Oidc providers are discovered from their
+// issuer right away, so a misconfigured provider fails the start of the service.
+func NewRegistry(ctx context.Context, configs []Config) (Registry, error) {
+	providers := make(map[string]Provider, len(configs))
+
+	for _, cfg := range configs {
+		if _, ok := providers[cfg.Name]; ok {
+			return Registry{}, fmt.Errorf("login provider %q is configured twice", cfg.Name)
+		}
+
+		provider, err := NewProvider(ctx, cfg)
+		if err != nil {
+			return Registry{}, fmt.Errorf("create login provider %q: %w", cfg.Name, err)
+		}
+
+		providers[cfg.Name] = provider
+	}
+
+	return Registry{providers: providers}, nil
+}
+
+func (r Registry) Provider(name string) (Provider, bool) {
+	provider, ok := r.providers[name]
+	return provider, ok
+}
+
+func NewProvider(ctx context.Context, cfg Config) (Provider, error) {
+	if cfg.Name == "" || cfg.ClientID == "" || cfg.RedirectURL == "" {
+		return nil, fmt.Errorf("name, client id and redirect url are required")
+	}
+
+	switch cfg.Type {
+	case TypeOIDC:
+		if cfg.Issuer == "" {
+			return nil, fmt.Errorf("issuer is required for an oidc provider")
+		}
+		return newOIDCProvider(ctx, cfg, oidcOptions{})
+	case TypeGoogle:
+		return newOIDCProvider(ctx, withIssuer(cfg, "https://accounts.google.com"), oidcOptions{})
+	case TypeGitLab:
+		return newOIDCProvider(ctx, withIssuer(cfg, "https://gitlab.com"), oidcOptions{})
+	case TypeApple:
+		// apple posts the callback when the name or email scope is requested and has no
+		// userinfo endpoint, the id token carries every claim
+		return newOIDCProvider(ctx, withIssuer(cfg, "https://appleid.apple.com"), oidcOptions{
+			formPost: true,
+		})
+	case TypeMicrosoft:
+		return newMicrosoftProvider(ctx, cfg)
+	case TypeGitHub:
+		return newGitHubProvider(cfg), nil
+	default:
+		return nil, fmt.Errorf("unsupported login provider type %q", cfg.Type)
+	}
+}
+
+func withIssuer(cfg Config, issuer string) Config {
+	if cfg.Issuer == "" {
+		cfg.Issuer = issuer
+	}
+
+	return cfg
+}
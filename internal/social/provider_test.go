@@ -0,0 +1,295 @@
+package social
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var testAuthParams = AuthParams{
+	State:        "state",
+	CodeVerifier: "verifier-0123456789-0123456789-0123456789",
+	Nonce:        "nonce",
+}
+
+type testIssuer struct {
+	*httptest.Server
+	key      *rsa.PrivateKey
+	claims   jwt.MapClaims
+	userinfo map[string]interface{}
+}
+
+// newTestIssuer serves the discovery document, jwks, token and userinfo endpoints of a
+// minimal openid provider. The token endpoint answers every code sent with the verifier
+// of testAuthParams with an id token carrying the claims of the issuer.
+func newTestIssuer(t *testing.T) *testIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	iss := &testIssuer{key: key}
+	mux := http.NewServeMux()
+	iss.Server = httptest.NewServer(mux)
+	t.Cleanup(iss.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"issuer":                                iss.URL,
+			"authorization_endpoint":                iss.URL + "/authorize",
+			"token_endpoint":                        iss.URL + "/token",
+			"userinfo_endpoint":                     iss.URL + "/userinfo",
+			"jwks_uri":                              iss.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test",
+				"alg": "RS256",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code_verifier") != testAuthParams.CodeVerifier {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, iss.claims)
+		idToken.Header["kid"] = "test"
+		signed, err := idToken.SignedString(key)
+		if err != nil {
+			t.Errorf("SignedString: %v", err)
+		}
+
+		writeJSON(w, map[string]interface{}{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     signed,
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, iss.userinfo)
+	})
+
+	return iss
+}
+
+func (iss *testIssuer) idTokenClaims(extra jwt.MapClaims) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"iss":   iss.URL,
+		"sub":   "subject-1",
+		"aud":   "client",
+		"nonce": testAuthParams.Nonce,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Minute).Unix(),
+	}
+	for k, v := range extra {
+		claims[k] = v
+	}
+
+	return claims
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func testConfig(typ, issuer string) Config {
+	return Config{
+		Name:         "corporate",
+		Type:         typ,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost/callback",
+		Issuer:       issuer,
+	}
+}
+
+func TestOIDCProviderExchange(t *testing.T) {
+	iss := newTestIssuer(t)
+	iss.claims = iss.idTokenClaims(jwt.MapClaims{
+		"email":              "User@Example.com",
+		"email_verified":     "true",
+		"name":               "User",
+		"preferred_username": "user",
+	})
+
+	provider, err := NewProvider(context.Background(), testConfig(TypeOIDC, iss.URL))
+	if err != nil {
+		t.Fatalf("NewProvider: %v", err)
+	}
+
+	url := provider.AuthCodeURL(testAuthParams)
+	if !strings.HasPrefix(url, iss.URL+"/authorize?") {
+		t.Fatalf("AuthCodeURL = %s, want the discovered authorization endpoint", url)
+	}
+	for _, param := range []string{"state=state", "nonce=nonce", "code_challenge_method=S256", "code_challenge="} {
+		if !strings.Contains(url, param) {
+			t.Fatalf("AuthCodeURL = %s, want %s", url, param)
+		}
+	}
+
+	identity, err := provider.Exchange(context.Background(), "code", testAuthParams)
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+
+	if identity.Provider != "corporate" || identity.Subject != "subject-1" {
+		t.Fatalf("identity = %+v, want provider corporate and subject subject-1", identity)
+	}
+	if identity.Email != "user@example.com" || !identity.EmailVerified {
+		t.Fatalf("identity = %+v, want the verified lower case email", identity)
+	}
+	if identity.Username != "user" || identity.Name != "User" {
+		t.Fatalf("identity = %+v, want the profile claims", identity)
+	}
+}
+
+func TestOIDCProviderExchangeUserInfo(t *testing.T) {
+	iss := newTestIssuer(t)
+	iss.claims = iss.idTokenClaims(nil)
+	iss.userinfo = map[string]interface{}{
+		"sub":            "subject-1",
+		"email":          "user@example.com",
+		"email_verified": true,
+	}
+
+	provider, err := NewProvider(context.Background(), testConfig(TypeOIDC, iss.URL))
+	if err != nil {
+		t.Fatalf("NewProvider: %v", err)
+	}
+
+	identity, err := provider.Exchange(context.Background(), "code", testAuthParams)
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if identity.Email != "user@example.com" || !identity.EmailVerified {
+		t.Fatalf("identity = %+v, want the email from userinfo", identity)
+	}
+
+	iss.userinfo["sub"] = "someone-else"
+	if _, err = provider.Exchange(context.Background(), "code", testAuthParams); err == nil {
+		t.Fatal("Exchange accepted userinfo of another subject")
+	}
+}
+
+func TestOIDCProviderRejectsForeignToken(t *testing.T) {
+	iss := newTestIssuer(t)
+
+	provider, err := NewProvider(context.Background(), testConfig(TypeOIDC, iss.URL))
+	if err != nil {
+		t.Fatalf("NewProvider: %v", err)
+	}
+
+	iss.claims = iss.idTokenClaims(jwt.MapClaims{"aud": "another-client"})
+	if _, err = provider.Exchange(context.Background(), "code", testAuthParams); err == nil {
+		t.Fatal("Exchange accepted an id token issued to another client")
+	}
+
+	iss.claims = iss.idTokenClaims(jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()})
+	if _, err = provider.Exchange(context.Background(), "code", testAuthParams); err == nil {
+		t.Fatal("Exchange accepted an expired id token")
+	}
+
+	iss.claims = iss.idTokenClaims(jwt.MapClaims{"nonce": "another-nonce"})
+	if _, err = provider.Exchange(context.Background(), "code", testAuthParams); err == nil {
+		t.Fatal("Exchange accepted an id token issued for another authorization request")
+	}
+
+	iss.claims = iss.idTokenClaims(nil)
+	params := testAuthParams
+	params.CodeVerifier = "another-verifier-0123456789-0123456789"
+	if _, err = provider.Exchange(context.Background(), "code", params); err == nil {
+		t.Fatal("Exchange succeeded with a wrong code verifier")
+	}
+}
+
+func TestGitHubProviderExchange(t *testing.T) {
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"access_token": "access-token", "token_type": "bearer"})
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, map[string]interface{}{"id": 42, "login": "octocat", "name": "The Octocat"})
+	})
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []map[string]interface{}{
+			{"email": "public@example.com", "primary": false, "verified": true},
+			{"email": "Octocat@Example.com", "primary": true, "verified": true},
+		})
+	})
+
+	cfg := testConfig(TypeGitHub, "")
+	cfg.Name = "github"
+	cfg.AuthURL = srv.URL + "/login/oauth/authorize"
+	cfg.TokenURL = srv.URL + "/login/oauth/access_token"
+	cfg.APIURL = srv.URL
+
+	provider, err := NewProvider(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("NewProvider: %v", err)
+	}
+
+	identity, err := provider.Exchange(context.Background(), "code", testAuthParams)
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+
+	if identity.Subject != "42" || identity.Username != "octocat" {
+		t.Fatalf("identity = %+v, want subject 42 and username octocat", identity)
+	}
+	if identity.Email != "octocat@example.com" || !identity.EmailVerified {
+		t.Fatalf("identity = %+v, want the verified primary email", identity)
+	}
+}
+
+func TestNewRegistry(t *testing.T) {
+	cfg := testConfig(TypeGitHub, "")
+
+	registry, err := NewRegistry(context.Background(), []Config{cfg})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+	if _, ok := registry.Provider("corporate"); !ok {
+		t.Fatal("configured provider is missing from the registry")
+	}
+	if _, ok := registry.Provider("google"); ok {
+		t.Fatal("registry returned a provider that was not configured")
+	}
+
+	if _, err = NewRegistry(context.Background(), []Config{cfg, cfg}); err == nil {
+		t.Fatal("NewRegistry accepted a provider configured twice")
+	}
+
+	cfg.Type = "myspace"
+	if _, err = NewRegistry(context.Background(), []Config{cfg}); err == nil {
+		t.Fatal("NewRegistry accepted an unsupported provider type")
+	}
+}
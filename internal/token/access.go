@@ -2,7 +2,6 @@ package token
 
 import (
 	"github.com/google/uuid"
-	"github.com/umisto/restkit/token"
 	"github.com/umisto/sso-svc/internal/domain/entity"
 )
 
@@ -11,13 +10,20 @@ func (s Service) EncryptAccess(token string) (string, error) {
 }
 
 func (s Service) GenerateAccess(user entity.Account, sessionID uuid.UUID) (string, error) {
-	return token.GenerateAccountJWT(token.GenerateAccountJwtRequest{
-		Issuer:    s.iss,
-		AccountID: user.ID,
-		//Audience:  []string{"gateway"},
-		SessionID: sessionID,
-		Role:      user.Role,
-		Username:  user.Username,
-		Ttl:       s.accessTTL,
-	}, s.accessSK)
+	return s.signAccountToken(accessTokenType, user, sessionID, nil, s.accessTTL, "", nil)
+}
+
+// GenerateClientAccess issues an access token to an oauth client, it is addressed to the
+// client and carries the scopes the user granted it.
+func (s Service) GenerateClientAccess(
+	user entity.Account,
+	sessionID uuid.UUID,
+	clientID string,
+	scopes []string,
+) (string, error) {
+	return s.signAccountToken(accessTokenType, user, sessionID, []string{clientID}, s.accessTTL, clientID, scopes)
+}
+
+func (s Service) ParseAccess(tokenStr string) (AccountClaims, error) {
+	return s.parseAccountToken(accessTokenType, tokenStr)
 }
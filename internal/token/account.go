@@ -0,0 +1,111 @@
+package token
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+const (
+	accessTokenType  = "at+jwt"
+	refreshTokenType = "rt+jwt"
+)
+
+type AccountClaims struct {
+	jwt.RegisteredClaims
+	SessionID uuid.UUID `json:"session_id"`
+	Role      string    `json:"role"`
+	Username  string    `json:"username"`
+	// ClientID is the oauth client the token was issued to, empty for our own front-ends.
+	ClientID string `json:"client_id,omitempty"`
+	// Scope lists the scopes granted to the client, separated by spaces.
+	Scope string `json:"scope,omitempty"`
+}
+
+func (c AccountClaims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// signAccountToken signs the claims with the active key of the ring. typ tells access
+// and refresh tokens apart, so one can never be used in place of the other. clientID
+// and scopes are set for the tokens of an oauth client.
+func (s Service) signAccountToken(
+	typ string,
+	account entity.Account,
+	sessionID uuid.UUID,
+	audience []string,
+	ttl time.Duration,
+	clientID string,
+	scopes []string,
+) (string, error) {
+	key, err := s.keys.signer()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+
+	claims := AccountClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    s.iss,
+			Subject:   account.ID.String(),
+			Audience:  audience,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		SessionID: sessionID,
+		Role:      account.Role,
+		Username:  account.Username,
+		ClientID:  clientID,
+		Scope:     strings.Join(scopes, " "),
+	}
+
+	t := jwt.NewWithClaims(key.method, claims)
+	t.Header["kid"] = key.id
+	t.Header["typ"] = typ
+
+	signed, err := t.SignedString(key.private)
+	if err != nil {
+		return "", fmt.Errorf("sign %s with key %s: %w", typ, key.id, err)
+	}
+
+	return signed, nil
+}
+
+func (s Service) parseAccountToken(typ, tokenStr string, opts ...jwt.ParserOption) (AccountClaims, error) {
+	var claims AccountClaims
+
+	opts = append(opts,
+		jwt.WithValidMethods(SigningAlgorithms),
+		jwt.WithIssuer(s.iss),
+		jwt.WithExpirationRequired(),
+	)
+
+	_, err := jwt.ParseWithClaims(tokenStr, &claims, func(t *jwt.Token) (interface{}, error) {
+		if t.Header["typ"] != typ {
+			return nil, fmt.Errorf("unexpected token type %v", t.Header["typ"])
+		}
+
+		kid, _ := t.Header["kid"].(string)
+
+		key, public, err := s.keys.verifier(kid)
+		if err != nil {
+			return nil, err
+		}
+		if t.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("token algorithm %s does not match key %s", t.Method.Alg(), kid)
+		}
+
+		return public, nil
+	}, opts...)
+	if err != nil {
+		return AccountClaims{}, fmt.Errorf("parse %s: %w", typ, err)
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,155 @@
+package token
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+const testEncryptionKey = "0123456789abcdef"
+
+type testKeyStore []entity.SigningKey
+
+func (s testKeyStore) GetSigningKeys(context.Context) ([]entity.SigningKey, error) {
+	return s, nil
+}
+
+func newTestService(t *testing.T, keys ...entity.SigningKey) Service {
+	ring := NewKeyRing(nil, testKeyStore(keys), testEncryptionKey)
+	if err := ring.Load(context.Background()); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	return NewManager(Config{
+		AccessTTL:  time.Minute,
+		RefreshTTL: time.Hour,
+		Keys:       ring,
+		Iss:        "sso-svc",
+	})
+}
+
+func newTestSigningKey(t *testing.T, algorithm string) entity.SigningKey {
+	key, err := NewSigningKey(algorithm, testEncryptionKey)
+	if err != nil {
+		t.Fatalf("NewSigningKey(%s): %v", algorithm, err)
+	}
+
+	return key
+}
+
+func TestAccountTokensRoundTrip(t *testing.T) {
+	account := entity.Account{ID: uuid.New(), Username: "user", Role: "user"}
+	sessionID := uuid.New()
+
+	for _, algorithm := range SigningAlgorithms {
+		t.Run(algorithm, func(t *testing.T) {
+			key := newTestSigningKey(t, algorithm)
+			s := newTestService(t, key)
+
+			access, err := s.GenerateAccess(account, sessionID)
+			if err != nil {
+				t.Fatalf("GenerateAccess: %v", err)
+			}
+
+			claims, err := s.ParseAccess(access)
+			if err != nil {
+				t.Fatalf("ParseAccess: %v", err)
+			}
+			if claims.Subject != account.ID.String() || claims.SessionID != sessionID {
+				t.Fatalf("ParseAccess: unexpected claims %+v", claims)
+			}
+
+			refresh, err := s.GenerateRefresh(account, sessionID)
+			if err != nil {
+				t.Fatalf("GenerateRefresh: %v", err)
+			}
+			if _, err = s.ParseRefreshClaims(refresh); err != nil {
+				t.Fatalf("ParseRefreshClaims: %v", err)
+			}
+
+			if _, err = s.ParseAccess(refresh); err == nil {
+				t.Fatalf("ParseAccess: expected error for refresh token")
+			}
+			if _, err = s.ParseRefreshClaims(access); err == nil {
+				t.Fatalf("ParseRefreshClaims: expected error for access token")
+			}
+
+			jwks := s.keys.JWKS()
+			if len(jwks.Keys) != 1 || jwks.Keys[0].Kid != key.ID || jwks.Keys[0].Alg != algorithm {
+				t.Fatalf("JWKS: unexpected keys %+v", jwks.Keys)
+			}
+		})
+	}
+}
+
+func TestRetiringKeyStillVerifies(t *testing.T) {
+	account := entity.Account{ID: uuid.New(), Role: "user"}
+
+	old := newTestSigningKey(t, AlgorithmES256)
+	access, err := newTestService(t, old).GenerateAccess(account, uuid.New())
+	if err != nil {
+		t.Fatalf("GenerateAccess: %v", err)
+	}
+
+	retireAt := time.Now().Add(time.Hour)
+	old.Status = entity.SigningKeyStatusRetiring
+	old.ExpiresAt = &retireAt
+
+	rotated := newTestService(t, newTestSigningKey(t, AlgorithmEdDSA), old)
+	if _, err = rotated.ParseAccess(access); err != nil {
+		t.Fatalf("ParseAccess: expected token of retiring key to verify, got %v", err)
+	}
+
+	dropped := newTestService(t, newTestSigningKey(t, AlgorithmEdDSA))
+	if _, err = dropped.ParseAccess(access); err == nil {
+		t.Fatalf("ParseAccess: expected error for token of an unknown key")
+	}
+}
+
+func TestClientTokensCarryTheGrant(t *testing.T) {
+	account := entity.Account{ID: uuid.New(), Role: "user"}
+	sessionID := uuid.New()
+	scopes := []string{entity.OAuthScopeOpenID, entity.OAuthScopeOfflineAccess}
+
+	s := newTestService(t, newTestSigningKey(t, AlgorithmES256))
+
+	access, err := s.GenerateClientAccess(account, sessionID, "client", scopes)
+	if err != nil {
+		t.Fatalf("GenerateClientAccess: %v", err)
+	}
+
+	claims, err := s.ParseAccess(access)
+	if err != nil {
+		t.Fatalf("ParseAccess: %v", err)
+	}
+	if claims.ClientID != "client" || len(claims.Audience) != 1 || claims.Audience[0] != "client" {
+		t.Fatalf("ParseAccess: expected token addressed to the client, got %+v", claims)
+	}
+	if got := claims.Scopes(); len(got) != len(scopes) || got[0] != scopes[0] || got[1] != scopes[1] {
+		t.Fatalf("ParseAccess: expected scopes %v, got %v", scopes, got)
+	}
+
+	refresh, err := s.GenerateClientRefresh(account, sessionID, "client", scopes)
+	if err != nil {
+		t.Fatalf("GenerateClientRefresh: %v", err)
+	}
+
+	claims, err = s.ParseRefreshClaims(refresh)
+	if err != nil {
+		t.Fatalf("ParseRefreshClaims: %v", err)
+	}
+	if claims.ClientID != "client" {
+		t.Fatalf("ParseRefreshClaims: expected client id, got %+v", claims)
+	}
+
+	first, err := s.GenerateAccess(account, sessionID)
+	if err != nil {
+		t.Fatalf("GenerateAccess: %v", err)
+	}
+	if claims, err = s.ParseAccess(first); err != nil || claims.ClientID != "" || claims.Scope != "" {
+		t.Fatalf("ParseAccess: expected first party token without a grant, got %+v, %v", claims, err)
+	}
+}
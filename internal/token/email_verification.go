@@ -0,0 +1,85 @@
+package token
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	emailVerificationAudience = "email_verification"
+	emailChangeAudience       = "email_change"
+)
+
+type emailVerificationClaims struct {
+	jwt.RegisteredClaims
+	Email string `json:"email"`
+}
+
+// GenerateEmailVerification issues the code confirming the current email of the account.
+func (s Service) GenerateEmailVerification(accountID uuid.UUID, email string) (string, time.Time, error) {
+	return s.generateEmailCode(emailVerificationAudience, accountID, email)
+}
+
+func (s Service) ParseEmailVerification(code string) (uuid.UUID, string, error) {
+	return s.parseEmailCode(emailVerificationAudience, code)
+}
+
+// GenerateEmailChange issues the code confirming the new email of the account, it is
+// not accepted as a verification code and the other way round.
+func (s Service) GenerateEmailChange(accountID uuid.UUID, email string) (string, time.Time, error) {
+	return s.generateEmailCode(emailChangeAudience, accountID, email)
+}
+
+func (s Service) ParseEmailChange(code string) (uuid.UUID, string, error) {
+	return s.parseEmailCode(emailChangeAudience, code)
+}
+
+func (s Service) generateEmailCode(audience string, accountID uuid.UUID, email string) (string, time.Time, error) {
+	now := time.Now().UTC()
+	expiresAt := now.Add(s.emailVerificationTTL)
+
+	claims := emailVerificationClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    s.iss,
+			Subject:   accountID.String(),
+			Audience:  jwt.ClaimStrings{audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		Email: email,
+	}
+
+	code, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.emailVerificationSK))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("sign %s code: %w", audience, err)
+	}
+
+	return code, expiresAt, nil
+}
+
+func (s Service) parseEmailCode(audience, code string) (uuid.UUID, string, error) {
+	var claims emailVerificationClaims
+
+	_, err := jwt.ParseWithClaims(code, &claims, func(t *jwt.Token) (interface{}, error) {
+		return []byte(s.emailVerificationSK), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.iss),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return uuid.Nil, "", fmt.Errorf("parse %s code: %w", audience, err)
+	}
+
+	accountID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return uuid.Nil, "", fmt.Errorf("parse %s subject: %w", audience, err)
+	}
+
+	return accountID, claims.Email, nil
+}
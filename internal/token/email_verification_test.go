@@ -0,0 +1,49 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestEmailCodesAreNotInterchangeable(t *testing.T) {
+	s := NewManager(Config{
+		Iss:                  "sso-svc",
+		EmailVerificationSK:  "email-verification-secret",
+		EmailVerificationTTL: time.Minute,
+	})
+	accountID := uuid.New()
+
+	verification, _, err := s.GenerateEmailVerification(accountID, "user@example.com")
+	if err != nil {
+		t.Fatalf("GenerateEmailVerification: %v", err)
+	}
+	change, _, err := s.GenerateEmailChange(accountID, "new@example.com")
+	if err != nil {
+		t.Fatalf("GenerateEmailChange: %v", err)
+	}
+
+	id, email, err := s.ParseEmailVerification(verification)
+	if err != nil {
+		t.Fatalf("ParseEmailVerification: %v", err)
+	}
+	if id != accountID || email != "user@example.com" {
+		t.Fatalf("ParseEmailVerification: got %s %q", id, email)
+	}
+
+	id, email, err = s.ParseEmailChange(change)
+	if err != nil {
+		t.Fatalf("ParseEmailChange: %v", err)
+	}
+	if id != accountID || email != "new@example.com" {
+		t.Fatalf("ParseEmailChange: got %s %q", id, email)
+	}
+
+	if _, _, err = s.ParseEmailChange(verification); err == nil {
+		t.Fatal("ParseEmailChange: accepted an email verification code")
+	}
+	if _, _, err = s.ParseEmailVerification(change); err == nil {
+		t.Fatal("ParseEmailVerification: accepted an email change code")
+	}
+}
@@ -0,0 +1,73 @@
+package token
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+type IDTokenClaims struct {
+	jwt.RegisteredClaims
+	AuthTime          *jwt.NumericDate `json:"auth_time,omitempty"`
+	Nonce             string           `json:"nonce,omitempty"`
+	PreferredUsername string           `json:"preferred_username,omitempty"`
+	Email             string           `json:"email,omitempty"`
+	EmailVerified     *bool            `json:"email_verified,omitempty"`
+}
+
+type IDTokenParams struct {
+	ClientID string
+	Nonce    string
+	AuthTime time.Time
+	Scopes   []string
+}
+
+// GenerateIDToken builds the openid connect id token for the client. Profile and email
+// claims are only added when the matching scope was granted.
+func (s Service) GenerateIDToken(
+	account entity.Account,
+	email entity.AccountEmail,
+	params IDTokenParams,
+) (string, error) {
+	key, err := s.keys.signer()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+
+	claims := IDTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    s.oidcIss,
+			Subject:   account.ID.String(),
+			Audience:  jwt.ClaimStrings{params.ClientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.accessTTL)),
+		},
+		AuthTime: jwt.NewNumericDate(params.AuthTime),
+		Nonce:    params.Nonce,
+	}
+
+	if slices.Contains(params.Scopes, entity.OAuthScopeProfile) {
+		claims.PreferredUsername = account.Username
+	}
+	if slices.Contains(params.Scopes, entity.OAuthScopeEmail) {
+		claims.Email = email.Email
+		claims.EmailVerified = &email.Verified
+	}
+
+	t := jwt.NewWithClaims(key.method, claims)
+	t.Header["kid"] = key.id
+
+	signed, err := t.SignedString(key.private)
+	if err != nil {
+		return "", fmt.Errorf("sign id token with key %s: %w", key.id, err)
+	}
+
+	return signed, nil
+}
@@ -0,0 +1,64 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+func TestIDTokenClaimsFollowScopes(t *testing.T) {
+	s := newTestService(t, newTestSigningKey(t, AlgorithmES256))
+	s.oidcIss = "https://sso.example.com"
+
+	account := entity.Account{ID: uuid.New(), Username: "user"}
+	email := entity.AccountEmail{AccountID: account.ID, Email: "user@example.com", Verified: true}
+
+	parse := func(raw string) IDTokenClaims {
+		var claims IDTokenClaims
+		_, err := jwt.ParseWithClaims(raw, &claims, func(tk *jwt.Token) (interface{}, error) {
+			_, public, err := s.keys.verifier(tk.Header["kid"].(string))
+			return public, err
+		}, jwt.WithIssuer(s.oidcIss), jwt.WithAudience("client"))
+		if err != nil {
+			t.Fatalf("parse id token: %v", err)
+		}
+
+		return claims
+	}
+
+	raw, err := s.GenerateIDToken(account, email, IDTokenParams{
+		ClientID: "client",
+		Nonce:    "nonce",
+		AuthTime: time.Now(),
+		Scopes:   []string{entity.OAuthScopeOpenID},
+	})
+	if err != nil {
+		t.Fatalf("GenerateIDToken: %v", err)
+	}
+
+	claims := parse(raw)
+	if claims.Nonce != "nonce" || claims.Subject != account.ID.String() {
+		t.Fatalf("GenerateIDToken: unexpected claims %+v", claims)
+	}
+	if claims.Email != "" || claims.PreferredUsername != "" {
+		t.Fatalf("GenerateIDToken: expected no profile or email claims without the scopes, got %+v", claims)
+	}
+
+	raw, err = s.GenerateIDToken(account, email, IDTokenParams{
+		ClientID: "client",
+		AuthTime: time.Now(),
+		Scopes:   []string{entity.OAuthScopeOpenID, entity.OAuthScopeProfile, entity.OAuthScopeEmail},
+	})
+	if err != nil {
+		t.Fatalf("GenerateIDToken: %v", err)
+	}
+
+	claims = parse(raw)
+	if claims.Email != email.Email || claims.EmailVerified == nil || !*claims.EmailVerified ||
+		claims.PreferredUsername != account.Username {
+		t.Fatalf("GenerateIDToken: expected profile and email claims, got %+v", claims)
+	}
+}
@@ -0,0 +1,128 @@
+package token
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/umisto/logium"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+type KeyStore interface {
+	GetSigningKeys(ctx context.Context) ([]entity.SigningKey, error)
+}
+
+// KeyRing keeps the signing keys in memory. The newest active key signs new tokens,
+// every loaded key, retiring ones included, is used for verification and published
+// in the jwks.
+type KeyRing struct {
+	log           logium.Logger
+	store         KeyStore
+	encryptionKey string
+
+	mu     sync.RWMutex
+	active signingKey
+	keys   map[string]signingKey
+	jwks   JWKS
+}
+
+func NewKeyRing(log logium.Logger, store KeyStore, encryptionKey string) *KeyRing {
+	return &KeyRing{
+		log:           log,
+		store:         store,
+		encryptionKey: encryptionKey,
+		keys:          map[string]signingKey{},
+	}
+}
+
+// Load replaces the keys of the ring with the ones from the store. The ring is left
+// untouched when loading fails or there is no active key.
+func (r *KeyRing) Load(ctx context.Context) error {
+	stored, err := r.store.GetSigningKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("get signing keys: %w", err)
+	}
+
+	var active signingKey
+	keys := make(map[string]signingKey, len(stored))
+	jwks := JWKS{Keys: make([]JWK, 0, len(stored))}
+
+	// stored keys are ordered newest first
+	for _, k := range stored {
+		key, err := parseSigningKey(k, r.encryptionKey)
+		if err != nil {
+			return err
+		}
+
+		if k.IsActive() && active.id == "" {
+			active = key
+		}
+
+		keys[key.id] = key
+		jwks.Keys = append(jwks.Keys, key.jwk)
+	}
+
+	if active.id == "" {
+		return fmt.Errorf("no active signing key")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.active = active
+	r.keys = keys
+	r.jwks = jwks
+
+	return nil
+}
+
+// Run reloads the ring every interval, so keys rotated from the cli are picked up
+// without a restart.
+func (r *KeyRing) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Load(ctx); err != nil {
+				r.log.WithError(err).Error("failed to reload signing keys")
+			}
+		}
+	}
+}
+
+func (r *KeyRing) JWKS() JWKS {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.jwks
+}
+
+func (r *KeyRing) signer() (signingKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.active.id == "" {
+		return signingKey{}, fmt.Errorf("no active signing key")
+	}
+
+	return r.active, nil
+}
+
+func (r *KeyRing) verifier(kid string) (signingKey, crypto.PublicKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, ok := r.keys[kid]
+	if !ok {
+		return signingKey{}, nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	return key, key.private.Public(), nil
+}
@@ -0,0 +1,63 @@
+package token
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const mfaChallengeAudience = "mfa_challenge"
+
+// GenerateMFAChallenge signs the challenge stored under challengeID, its id travels as
+// the jti claim.
+func (s Service) GenerateMFAChallenge(challengeID, accountID uuid.UUID) (string, time.Time, error) {
+	now := time.Now().UTC()
+	expiresAt := now.Add(s.mfaChallengeTTL)
+
+	claims := jwt.RegisteredClaims{
+		ID:        challengeID.String(),
+		Issuer:    s.iss,
+		Subject:   accountID.String(),
+		Audience:  jwt.ClaimStrings{mfaChallengeAudience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+
+	challenge, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.mfaChallengeSK))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("sign mfa challenge: %w", err)
+	}
+
+	return challenge, expiresAt, nil
+}
+
+// ParseMFAChallenge returns the id of the stored challenge and the account it was issued for.
+func (s Service) ParseMFAChallenge(challenge string) (uuid.UUID, uuid.UUID, error) {
+	var claims jwt.RegisteredClaims
+
+	_, err := jwt.ParseWithClaims(challenge, &claims, func(t *jwt.Token) (interface{}, error) {
+		return []byte(s.mfaChallengeSK), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.iss),
+		jwt.WithAudience(mfaChallengeAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("parse mfa challenge: %w", err)
+	}
+
+	challengeID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("parse mfa challenge id: %w", err)
+	}
+
+	accountID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("parse mfa challenge subject: %w", err)
+	}
+
+	return challengeID, accountID, nil
+}
@@ -3,21 +3,24 @@ package token
 import (
 	"fmt"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
-	"github.com/umisto/restkit/token"
 	"github.com/umisto/sso-svc/internal/domain/entity"
 )
 
 func (s Service) GenerateRefresh(account entity.Account, sessionID uuid.UUID) (string, error) {
-	return token.GenerateAccountJWT(token.GenerateAccountJwtRequest{
-		Issuer:    s.iss,
-		Audience:  []string{s.iss},
-		AccountID: account.ID,
-		SessionID: sessionID,
-		Username:  account.Username,
-		Role:      account.Role,
-		Ttl:       s.refreshTTL,
-	}, s.refreshSK)
+	return s.signAccountToken(refreshTokenType, account, sessionID, []string{s.iss}, s.refreshTTL, "", nil)
+}
+
+// GenerateClientRefresh issues a refresh token to an oauth client. Like every refresh
+// token it is addressed to us, the client only hands it back.
+func (s Service) GenerateClientRefresh(
+	account entity.Account,
+	sessionID uuid.UUID,
+	clientID string,
+	scopes []string,
+) (string, error) {
+	return s.signAccountToken(refreshTokenType, account, sessionID, []string{s.iss}, s.refreshTTL, clientID, scopes)
 }
 
 func (s Service) EncryptRefresh(token string) (string, error) {
@@ -33,6 +36,6 @@ func (s Service) DecryptRefresh(encryptedToken string) (string, error) {
 	return raw, nil
 }
 
-func (s Service) ParseRefreshClaims(tokenStr string) (token.AccountClaims, error) {
-	return token.VerifyAccountJWT(tokenStr, s.refreshSK)
+func (s Service) ParseRefreshClaims(tokenStr string) (AccountClaims, error) {
+	return s.parseAccountToken(refreshTokenType, tokenStr, jwt.WithAudience(s.iss))
 }
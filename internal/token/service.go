@@ -17,7 +17,17 @@ type Service struct {
 	accessTTL  time.Duration
 	refreshTTL time.Duration
 
-	iss string
+	emailVerificationSK  string
+	emailVerificationTTL time.Duration
+
+	mfaChallengeSK   string
+	mfaChallengeTTL  time.Duration
+	mfaEncryptionKey string
+
+	keys *KeyRing
+
+	iss     string
+	oidcIss string
 }
 
 type Config struct {
@@ -27,7 +37,17 @@ type Config struct {
 	AccessTTL  time.Duration
 	RefreshTTL time.Duration
 
-	Iss string
+	EmailVerificationSK  string
+	EmailVerificationTTL time.Duration
+
+	MFAChallengeSK   string
+	MFAChallengeTTL  time.Duration
+	MFAEncryptionKey string
+
+	Keys *KeyRing
+
+	Iss     string
+	OIDCIss string
 }
 
 func NewManager(cfg Config) Service {
@@ -38,7 +58,17 @@ func NewManager(cfg Config) Service {
 		accessTTL:  cfg.AccessTTL,
 		refreshTTL: cfg.RefreshTTL,
 
-		iss: cfg.Iss,
+		emailVerificationSK:  cfg.EmailVerificationSK,
+		emailVerificationTTL: cfg.EmailVerificationTTL,
+
+		mfaChallengeSK:   cfg.MFAChallengeSK,
+		mfaChallengeTTL:  cfg.MFAChallengeTTL,
+		mfaEncryptionKey: cfg.MFAEncryptionKey,
+
+		keys: cfg.Keys,
+
+		iss:     cfg.Iss,
+		oidcIss: cfg.OIDCIss,
 	}
 }
 
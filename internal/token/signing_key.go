@@ -0,0 +1,196 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/umisto/sso-svc/internal/domain/entity"
+)
+
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+var SigningAlgorithms = []string{AlgorithmRS256, AlgorithmES256, AlgorithmEdDSA}
+
+const rsaKeyBits = 2048
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+type signingKey struct {
+	id      string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	jwk     JWK
+}
+
+// NewSigningKey generates a key for the algorithm. The key id is the RFC 7638 thumbprint
+// of the public key and the private key is returned encrypted with encryptionKey.
+func NewSigningKey(algorithm, encryptionKey string) (entity.SigningKey, error) {
+	var (
+		private crypto.Signer
+		err     error
+	)
+
+	switch algorithm {
+	case AlgorithmRS256:
+		private, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case AlgorithmES256:
+		private, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgorithmEdDSA:
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return entity.SigningKey{}, fmt.Errorf("unsupported signing algorithm %q", algorithm)
+	}
+	if err != nil {
+		return entity.SigningKey{}, fmt.Errorf("generate %s key: %w", algorithm, err)
+	}
+
+	jwk, err := publicJWK(algorithm, private.Public())
+	if err != nil {
+		return entity.SigningKey{}, err
+	}
+
+	kid, err := thumbprint(jwk)
+	if err != nil {
+		return entity.SigningKey{}, err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return entity.SigningKey{}, fmt.Errorf("marshal %s key: %w", algorithm, err)
+	}
+
+	encrypted, err := encryptAESGCM(base64.StdEncoding.EncodeToString(der), []byte(encryptionKey))
+	if err != nil {
+		return entity.SigningKey{}, fmt.Errorf("encrypt %s key: %w", algorithm, err)
+	}
+
+	return entity.SigningKey{
+		ID:         kid,
+		Algorithm:  algorithm,
+		PrivateKey: encrypted,
+		Status:     entity.SigningKeyStatusActive,
+		CreatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+func parseSigningKey(key entity.SigningKey, encryptionKey string) (signingKey, error) {
+	method := jwt.GetSigningMethod(key.Algorithm)
+	if method == nil {
+		return signingKey{}, fmt.Errorf("unsupported signing algorithm %q of key %s", key.Algorithm, key.ID)
+	}
+
+	raw, err := decryptAESGCM(key.PrivateKey, []byte(encryptionKey))
+	if err != nil {
+		return signingKey{}, fmt.Errorf("decrypt signing key %s: %w", key.ID, err)
+	}
+
+	der, err := base64.StdEncoding.DecodeString(raw)
+	if err != nil {
+		return signingKey{}, fmt.Errorf("decode signing key %s: %w", key.ID, err)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return signingKey{}, fmt.Errorf("parse signing key %s: %w", key.ID, err)
+	}
+
+	private, ok := parsed.(crypto.Signer)
+	if !ok {
+		return signingKey{}, fmt.Errorf("signing key %s is not a signer", key.ID)
+	}
+
+	jwk, err := publicJWK(key.Algorithm, private.Public())
+	if err != nil {
+		return signingKey{}, err
+	}
+	jwk.Kid = key.ID
+
+	return signingKey{
+		id:      key.ID,
+		method:  method,
+		private: private,
+		jwk:     jwk,
+	}, nil
+}
+
+func publicJWK(algorithm string, public crypto.PublicKey) (JWK, error) {
+	jwk := JWK{Use: "sig", Alg: algorithm}
+
+	switch pub := public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		jwk.Kty = "EC"
+		jwk.Crv = pub.Curve.Params().Name
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, size)))
+		jwk.Y = base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, size)))
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	default:
+		return JWK{}, fmt.Errorf("unsupported public key type %T", public)
+	}
+
+	return jwk, nil
+}
+
+// thumbprint computes the RFC 7638 thumbprint, a hash over the required members of the
+// jwk in lexicographic order, which json.Marshal gives for a map.
+func thumbprint(jwk JWK) (string, error) {
+	members := map[string]string{"kty": jwk.Kty}
+	switch jwk.Kty {
+	case "RSA":
+		members["n"] = jwk.N
+		members["e"] = jwk.E
+	case "EC":
+		members["crv"] = jwk.Crv
+		members["x"] = jwk.X
+		members["y"] = jwk.Y
+	case "OKP":
+		members["crv"] = jwk.Crv
+		members["x"] = jwk.X
+	}
+
+	raw, err := json.Marshal(members)
+	if err != nil {
+		return "", fmt.Errorf("marshal jwk thumbprint: %w", err)
+	}
+
+	sum := sha256.Sum256(raw)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,106 @@
+// Package useragent derives a readable device, operating system and browser from a
+// User-Agent header. It knows the common clients only, anything else is reported as
+// unknown rather than guessed.
+package useragent
+
+import (
+	"strings"
+)
+
+const (
+	DeviceDesktop = "desktop"
+	DeviceMobile  = "mobile"
+	DeviceTablet  = "tablet"
+	DeviceBot     = "bot"
+
+	Unknown = "unknown"
+)
+
+type Info struct {
+	Device  string
+	OS      string
+	Browser string
+}
+
+type rule struct {
+	token string
+	name  string
+}
+
+// the first matching token wins, so the more specific tokens go first
+var (
+	osRules = []rule{
+		{"windows phone", "Windows Phone"},
+		{"windows", "Windows"},
+		{"ipad", "iPadOS"},
+		{"iphone", "iOS"},
+		{"ipod", "iOS"},
+		{"android", "Android"},
+		{"cros", "ChromeOS"},
+		{"mac os x", "macOS"},
+		{"macintosh", "macOS"},
+		{"linux", "Linux"},
+	}
+
+	browserRules = []rule{
+		{"edg/", "Edge"},
+		{"edga/", "Edge"},
+		{"edgios/", "Edge"},
+		{"opr/", "Opera"},
+		{"samsungbrowser/", "Samsung Internet"},
+		{"yabrowser/", "Yandex Browser"},
+		{"firefox/", "Firefox"},
+		{"fxios/", "Firefox"},
+		{"crios/", "Chrome"},
+		{"chrome/", "Chrome"},
+		{"safari/", "Safari"},
+		{"okhttp/", "OkHttp"},
+		{"curl/", "curl"},
+	}
+
+	botTokens = []string{"bot", "crawler", "spider", "slurp"}
+)
+
+func Parse(header string) Info {
+	ua := strings.ToLower(header)
+	if ua == "" {
+		return Info{Device: Unknown, OS: Unknown, Browser: Unknown}
+	}
+
+	return Info{
+		Device:  device(ua),
+		OS:      match(ua, osRules),
+		Browser: match(ua, browserRules),
+	}
+}
+
+func device(ua string) string {
+	for _, token := range botTokens {
+		if strings.Contains(ua, token) {
+			return DeviceBot
+		}
+	}
+
+	switch {
+	case strings.Contains(ua, "ipad"), strings.Contains(ua, "tablet"),
+		strings.Contains(ua, "android") && !strings.Contains(ua, "mobile"):
+		return DeviceTablet
+	case strings.Contains(ua, "mobi"), strings.Contains(ua, "iphone"), strings.Contains(ua, "ipod"):
+		return DeviceMobile
+	case strings.Contains(ua, "windows"), strings.Contains(ua, "macintosh"),
+		strings.Contains(ua, "x11"), strings.Contains(ua, "cros"):
+		return DeviceDesktop
+	default:
+		return Unknown
+	}
+}
+
+func match(ua string, rules []rule) string {
+	for _, r := range rules {
+		if strings.Contains(ua, r.token) {
+			return r.name
+		}
+	}
+
+	return Unknown
+}
@@ -0,0 +1,62 @@
+package useragent
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   Info
+	}{
+		{
+			name:   "chrome on windows",
+			header: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			want:   Info{Device: DeviceDesktop, OS: "Windows", Browser: "Chrome"},
+		},
+		{
+			name:   "edge is not reported as chrome",
+			header: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.0.0",
+			want:   Info{Device: DeviceDesktop, OS: "Windows", Browser: "Edge"},
+		},
+		{
+			name:   "safari on iphone",
+			header: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1",
+			want:   Info{Device: DeviceMobile, OS: "iOS", Browser: "Safari"},
+		},
+		{
+			name:   "firefox on macos",
+			header: "Mozilla/5.0 (Macintosh; Intel Mac OS X 14.1; rv:120.0) Gecko/20100101 Firefox/120.0",
+			want:   Info{Device: DeviceDesktop, OS: "macOS", Browser: "Firefox"},
+		},
+		{
+			name:   "android phone",
+			header: "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+			want:   Info{Device: DeviceMobile, OS: "Android", Browser: "Chrome"},
+		},
+		{
+			name:   "android tablet",
+			header: "Mozilla/5.0 (Linux; Android 13; SM-X700) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			want:   Info{Device: DeviceTablet, OS: "Android", Browser: "Chrome"},
+		},
+		{
+			name:   "crawler",
+			header: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			want:   Info{Device: DeviceBot, OS: Unknown, Browser: Unknown},
+		},
+		{
+			name:   "empty",
+			header: "",
+			want:   Info{Device: Unknown, OS: Unknown, Browser: Unknown},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Parse(tt.header); got != tt.want {
+				t.Fatalf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
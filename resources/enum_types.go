@@ -4,15 +4,46 @@ const (
 	RefreshSessionType = "refresh_session"
 	TokensPairType     = "tokens_pair"
 
-	LoginType = "login"
+	LoginType    = "login"
+	LoginMFAType = "login_mfa"
+
+	MFAChallengeType  = "mfa_challenge"
+	SetupTOTPType     = "setup_totp"
+	ConfirmTOTPType   = "confirm_totp"
+	DisableTOTPType   = "disable_totp"
+	TOTPSetupType     = "totp_setup"
+	RecoveryCodesType = "recovery_codes"
+
+	PasskeyType                   = "passkey"
+	PasskeyCeremonyType           = "passkey_ceremony"
+	FinishPasskeyRegistrationType = "finish_passkey_registration"
+	FinishPasskeyLoginType        = "finish_passkey_login"
+
+	OAuthAuthorizeType     = "oauth_authorize"
+	OAuthAuthorizationType = "oauth_authorization"
+
+	AccountIdentityType     = "account_identity"
+	LinkAccountIdentityType = "link_account_identity"
+	SocialAuthorizationType = "social_authorization"
 
 	UpdatePasswordType = "update_password"
 	UpdateUsernameType = "update_username"
 
+	ForgotPasswordType = "forgot_password"
+	ResetPasswordType  = "reset_password"
+
+	VerifyEmailType        = "verify_email"
+	UpdateEmailType        = "update_email"
+	ConfirmEmailUpdateType = "confirm_email_update"
+
 	RegistrationType      = "registration"
 	RegistrationAdminType = "registration_admin"
+	UpdateAccountType     = "update_account"
+	RevokeSessionsType    = "revoke_sessions"
 
 	AccountType        = "account"
 	AccountEmailType   = "account_email"
 	AccountSessionType = "account_session"
+
+	AuditLogEntryType = "audit_log_entry"
 )
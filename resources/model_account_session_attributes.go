@@ -29,6 +29,20 @@ type AccountSessionAttributes struct {
 	CreatedAt time.Time `json:"created_at"`
 	// last used date
 	LastUsed time.Time `json:"last_used"`
+	// Address the session was last refreshed from.
+	Ip string `json:"ip"`
+	// User agent of the client that opened the session.
+	UserAgent string `json:"user_agent"`
+	// Kind of device the session was opened on.
+	Device string `json:"device"`
+	// Operating system the session was opened on.
+	Os string `json:"os"`
+	// Browser the session was opened in.
+	Browser string `json:"browser"`
+	// Name the client gave itself when the session was opened.
+	ClientName *string `json:"client_name,omitempty"`
+	// Approximate location of the client when the session was opened.
+	GeoHint *string `json:"geo_hint,omitempty"`
 }
 
 type _AccountSessionAttributes AccountSessionAttributes
@@ -37,11 +51,16 @@ type _AccountSessionAttributes AccountSessionAttributes
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewAccountSessionAttributes(accountId uuid.UUID, createdAt time.Time, lastUsed time.Time) *AccountSessionAttributes {
+func NewAccountSessionAttributes(accountId uuid.UUID, createdAt time.Time, lastUsed time.Time, ip string, userAgent string, device string, os string, browser string) *AccountSessionAttributes {
 	this := AccountSessionAttributes{}
 	this.AccountId = accountId
 	this.CreatedAt = createdAt
 	this.LastUsed = lastUsed
+	this.Ip = ip
+	this.UserAgent = userAgent
+	this.Device = device
+	this.Os = os
+	this.Browser = browser
 	return &this
 }
 
@@ -125,6 +144,190 @@ func (o *AccountSessionAttributes) SetLastUsed(v time.Time) {
 	o.LastUsed = v
 }
 
+// GetIp returns the Ip field value
+func (o *AccountSessionAttributes) GetIp() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.Ip
+}
+
+// GetIpOk returns a tuple with the Ip field value
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetIpOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Ip, true
+}
+
+// SetIp sets field value
+func (o *AccountSessionAttributes) SetIp(v string) {
+	o.Ip = v
+}
+
+// GetUserAgent returns the UserAgent field value
+func (o *AccountSessionAttributes) GetUserAgent() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.UserAgent
+}
+
+// GetUserAgentOk returns a tuple with the UserAgent field value
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetUserAgentOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.UserAgent, true
+}
+
+// SetUserAgent sets field value
+func (o *AccountSessionAttributes) SetUserAgent(v string) {
+	o.UserAgent = v
+}
+
+// GetDevice returns the Device field value
+func (o *AccountSessionAttributes) GetDevice() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.Device
+}
+
+// GetDeviceOk returns a tuple with the Device field value
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetDeviceOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Device, true
+}
+
+// SetDevice sets field value
+func (o *AccountSessionAttributes) SetDevice(v string) {
+	o.Device = v
+}
+
+// GetOs returns the Os field value
+func (o *AccountSessionAttributes) GetOs() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.Os
+}
+
+// GetOsOk returns a tuple with the Os field value
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetOsOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Os, true
+}
+
+// SetOs sets field value
+func (o *AccountSessionAttributes) SetOs(v string) {
+	o.Os = v
+}
+
+// GetBrowser returns the Browser field value
+func (o *AccountSessionAttributes) GetBrowser() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.Browser
+}
+
+// GetBrowserOk returns a tuple with the Browser field value
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetBrowserOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Browser, true
+}
+
+// SetBrowser sets field value
+func (o *AccountSessionAttributes) SetBrowser(v string) {
+	o.Browser = v
+}
+
+// GetClientName returns the ClientName field value if set, zero value otherwise.
+func (o *AccountSessionAttributes) GetClientName() string {
+	if o == nil || IsNil(o.ClientName) {
+		var ret string
+		return ret
+	}
+	return *o.ClientName
+}
+
+// GetClientNameOk returns a tuple with the ClientName field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetClientNameOk() (*string, bool) {
+	if o == nil || IsNil(o.ClientName) {
+		return nil, false
+	}
+	return o.ClientName, true
+}
+
+// HasClientName returns a boolean if a field has been set.
+func (o *AccountSessionAttributes) HasClientName() bool {
+	if o != nil && !IsNil(o.ClientName) {
+		return true
+	}
+
+	return false
+}
+
+// SetClientName gets a reference to the given string and assigns it to the ClientName field.
+func (o *AccountSessionAttributes) SetClientName(v string) {
+	o.ClientName = &v
+}
+
+// GetGeoHint returns the GeoHint field value if set, zero value otherwise.
+func (o *AccountSessionAttributes) GetGeoHint() string {
+	if o == nil || IsNil(o.GeoHint) {
+		var ret string
+		return ret
+	}
+	return *o.GeoHint
+}
+
+// GetGeoHintOk returns a tuple with the GeoHint field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetGeoHintOk() (*string, bool) {
+	if o == nil || IsNil(o.GeoHint) {
+		return nil, false
+	}
+	return o.GeoHint, true
+}
+
+// HasGeoHint returns a boolean if a field has been set.
+func (o *AccountSessionAttributes) HasGeoHint() bool {
+	if o != nil && !IsNil(o.GeoHint) {
+		return true
+	}
+
+	return false
+}
+
+// SetGeoHint gets a reference to the given string and assigns it to the GeoHint field.
+func (o *AccountSessionAttributes) SetGeoHint(v string) {
+	o.GeoHint = &v
+}
+
 func (o AccountSessionAttributes) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -138,6 +341,17 @@ func (o AccountSessionAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize["account_id"] = o.AccountId
 	toSerialize["created_at"] = o.CreatedAt
 	toSerialize["last_used"] = o.LastUsed
+	toSerialize["ip"] = o.Ip
+	toSerialize["user_agent"] = o.UserAgent
+	toSerialize["device"] = o.Device
+	toSerialize["os"] = o.Os
+	toSerialize["browser"] = o.Browser
+	if !IsNil(o.ClientName) {
+		toSerialize["client_name"] = o.ClientName
+	}
+	if !IsNil(o.GeoHint) {
+		toSerialize["geo_hint"] = o.GeoHint
+	}
 	return toSerialize, nil
 }
 
@@ -149,6 +363,11 @@ func (o *AccountSessionAttributes) UnmarshalJSON(data []byte) (err error) {
 		"account_id",
 		"created_at",
 		"last_used",
+		"ip",
+		"user_agent",
+		"device",
+		"os",
+		"browser",
 	}
 
 	allProperties := make(map[string]interface{})